@@ -133,6 +133,13 @@ type BackendSpec struct {
 	//
 	// +optional
 	Fallback *bool `json:"fallback,omitempty"`
+
+	// ProxyProtocol enables the Proxy Protocol when communicating with the backend,
+	// to send it the address of the original client. It takes precedence over the
+	// proxyProtocol of the BackendTrafficPolicy for this backend.
+	//
+	// +optional
+	ProxyProtocol *ProxyProtocol `json:"proxyProtocol,omitempty"`
 }
 
 // BackendConditionType is a type of condition for a backend. This type should be
@@ -37,16 +37,29 @@ type BackendTrafficPolicy struct {
 //
 // +kubebuilder:validation:XValidation:rule="has(self.targetRef) ? self.targetRef.group == 'gateway.networking.k8s.io' : true ", message="this policy can only have a targetRef.group of gateway.networking.k8s.io"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRef) ? self.targetRef.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'UDPRoute', 'TCPRoute', 'TLSRoute'] : true", message="this policy can only have a targetRef.kind of Gateway/HTTPRoute/GRPCRoute/TCPRoute/UDPRoute/TLSRoute"
-// +kubebuilder:validation:XValidation:rule="has(self.targetRef) ? !has(self.targetRef.sectionName) : true",message="this policy does not yet support the sectionName field"
+// +kubebuilder:validation:XValidation:rule="has(self.targetRef) ? (!has(self.targetRef.sectionName) || self.targetRef.kind in ['HTTPRoute', 'GRPCRoute']) : true",message="this policy does not yet support the sectionName field for Gateway/TCPRoute/UDPRoute/TLSRoute targets"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, ref.group == 'gateway.networking.k8s.io') : true ", message="this policy can only have a targetRefs[*].group of gateway.networking.k8s.io"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, ref.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'UDPRoute', 'TCPRoute', 'TLSRoute']) : true ", message="this policy can only have a targetRefs[*].kind of Gateway/HTTPRoute/GRPCRoute/TCPRoute/UDPRoute/TLSRoute"
-// +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, !has(ref.sectionName)) : true",message="this policy does not yet support the sectionName field"
+// +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, !has(ref.sectionName) || ref.kind in ['HTTPRoute', 'GRPCRoute']) : true",message="this policy does not yet support the sectionName field for Gateway/TCPRoute/UDPRoute/TLSRoute targets"
+// +kubebuilder:validation:XValidation:rule="has(self.mergeType) ? ((has(self.targetRef) ? self.targetRef.kind != 'Gateway' : true) && (has(self.targetRefs) ? self.targetRefs.all(ref, ref.kind != 'Gateway') : true) && (has(self.targetSelectors) ? self.targetSelectors.all(sel, sel.kind != 'Gateway') : true)) : true",message="mergeType can only be set when targeting xRoutes"
+// +kubebuilder:validation:XValidation:rule="has(self.tcpTunneling) ? ((has(self.targetRef) ? self.targetRef.kind in ['Gateway', 'TCPRoute'] : true) && (has(self.targetRefs) ? self.targetRefs.all(ref, ref.kind in ['Gateway', 'TCPRoute']) : true) && (has(self.targetSelectors) ? self.targetSelectors.all(sel, sel.kind in ['Gateway', 'TCPRoute']) : true)) : true",message="tcpTunneling can only be set when targeting Gateways or TCPRoutes"
 //
 // BackendTrafficPolicySpec defines the desired state of BackendTrafficPolicy.
 type BackendTrafficPolicySpec struct {
 	PolicyTargetReferences `json:",inline"`
 	ClusterSettings        `json:",inline"`
 
+	// MergeType determines how this policy is merged with the BackendTrafficPolicy
+	// targeting the parent Gateway of the targeted xRoute: the policy targeting the
+	// Gateway provides the defaults, and the fields set in this policy override them.
+	// This field cannot be set when targeting a Gateway.
+	//
+	// If unset, no merging occurs, and only the most specific policy takes effect.
+	//
+	// +kubebuilder:validation:Enum=StrategicMerge;JSONMerge
+	// +optional
+	MergeType *MergeType `json:"mergeType,omitempty"`
+
 	// RateLimit allows the user to limit the number of incoming requests
 	// to a predefined value based on attributes within the traffic flow.
 	// +optional
@@ -64,16 +77,45 @@ type BackendTrafficPolicySpec struct {
 	// +optional
 	UseClientProtocol *bool `json:"useClientProtocol,omitempty"`
 
+	// HTTPUpgrade defines the HTTP protocol upgrades allowed or rejected on the routes,
+	// such as `spdy/3.1` or custom protocols. The WebSocket upgrades are allowed on the
+	// HTTP/1.1 routes, unless they are disabled here.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	HTTPUpgrade []*ProtocolUpgradeConfig `json:"httpUpgrade,omitempty"`
+
+	// TCPTunneling tunnels the TCP connections to the backends in HTTP CONNECT
+	// requests. It is only supported for TCPRoutes: it can only be set when
+	// targeting Gateways or TCPRoutes, and only applies to the TCPRoutes of the
+	// targeted Gateways. The backends must be HTTP proxies accepting the CONNECT
+	// requests.
+	//
+	// +optional
+	TCPTunneling *TCPTunneling `json:"tcpTunneling,omitempty"`
+
 	// The compression config for the http streams.
 	//
 	// +optional
 	Compression []*Compression `json:"compression,omitempty"`
 
+	// ResponseCache defines the configuration of the HTTP response cache.
+	// If unspecified, the responses are not cached.
+	//
+	// +optional
+	ResponseCache *ResponseCache `json:"responseCache,omitempty"`
+
 	// ResponseOverride defines the configuration to override specific responses with a custom one.
 	// If multiple configurations are specified, the first one to match wins.
 	//
 	// +optional
 	ResponseOverride []*ResponseOverride `json:"responseOverride,omitempty"`
+
+	// Tracing overrides the tracing configuration of the proxy for the targeted routes.
+	// It has no effect when tracing isn't configured in the EnvoyProxy.
+	//
+	// +optional
+	Tracing *RouteTracing `json:"tracing,omitempty"`
 }
 
 // +kubebuilder:object:root=true
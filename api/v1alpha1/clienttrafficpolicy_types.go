@@ -58,6 +58,12 @@ type ClientTrafficPolicySpec struct {
 	//
 	// +optional
 	EnableProxyProtocol *bool `json:"enableProxyProtocol,omitempty"`
+	// ProxyProtocol configures the interpretation of the ProxyProtocol header, and
+	// adds the Client Address into the X-Forwarded-For header. It enables the
+	// ProxyProtocol, and takes precedence over EnableProxyProtocol.
+	//
+	// +optional
+	ProxyProtocol *ProxyProtocolSettings `json:"proxyProtocol,omitempty"`
 	// ClientIPDetectionSettings provides configuration for determining the original client IP address for requests.
 	//
 	// +optional
@@ -98,6 +104,12 @@ type ClientTrafficPolicySpec struct {
 	//
 	// +optional
 	HealthCheck *HealthCheckSettings `json:"healthCheck,omitempty"`
+	// IPTagging tags the requests based on the IP address of the client.
+	// The tags can be used to classify the clients in the rate limit rules,
+	// the external authorization services and the access logs.
+	//
+	// +optional
+	IPTagging *IPTaggingSettings `json:"ipTagging,omitempty"`
 }
 
 // HeaderSettings provides configuration options for headers on the listener.
@@ -219,6 +231,17 @@ const (
 	XFCCCertDataURI XFCCCertData = "URI"
 )
 
+// ProxyProtocolSettings provides configuration for the ProxyProtocol on the listener.
+type ProxyProtocolSettings struct {
+	// Optional allows the connections without a ProxyProtocol header, for example from
+	// the health checks of a load balancer which doesn't send it. The client address of
+	// these connections is the address of the peer. Defaults to false, which means that
+	// the connections without a ProxyProtocol header are closed.
+	//
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
 // ClientIPDetectionSettings provides configuration for determining the original client IP address for requests.
 //
 // +kubebuilder:validation:XValidation:rule="!(has(self.xForwardedFor) && has(self.customHeader))",message="customHeader cannot be used in conjunction with xForwardedFor"
@@ -317,6 +340,38 @@ type HealthCheckSettings struct {
 	Path string `json:"path"`
 }
 
+// IPTaggingSettings provides configuration to tag the requests based on the IP address of the client.
+// The IP address of the client is determined according to the ClientIPDetection settings.
+type IPTaggingSettings struct {
+	// Tags defines the tags and the CIDR ranges of the clients they are applied to.
+	// A request is tagged with all the tags with a CIDR range containing the IP address of the client.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Tags []IPTag `json:"tags"`
+	// Header is the name of the request header the tags are set in, as a comma separated list.
+	// The values of the header sent by the clients are removed.
+	// Defaults to x-envoy-ip-tags.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Header *string `json:"header,omitempty"`
+}
+
+// IPTag defines a tag applied to the requests of the clients in the CIDR ranges.
+type IPTag struct {
+	// Name of the tag, such as "corp", "vpn" or "internet".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+	// CIDRs are the IP CIDR ranges of the clients the tag is applied to.
+	//
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []CIDR `json:"cidrs"`
+}
+
 const (
 	// PolicyConditionOverridden indicates whether the policy has
 	// completely attached to all the sections within the target or not.
@@ -330,6 +385,19 @@ const (
 	// PolicyReasonOverridden is used with the "Overridden" condition when the policy
 	// has been overridden by another policy targeting a section within the same target.
 	PolicyReasonOverridden gwapiv1a2.PolicyConditionReason = "Overridden"
+
+	// PolicyConditionMerged indicates whether the policy has been merged
+	// with another policy targeting a parent resource of its target.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "Merged"
+	//
+	PolicyConditionMerged gwapiv1a2.PolicyConditionType = "Merged"
+
+	// PolicyReasonMerged is used with the "Merged" condition when the policy
+	// has been merged with another policy targeting a parent resource of its target.
+	PolicyReasonMerged gwapiv1a2.PolicyConditionReason = "Merged"
 )
 
 //+kubebuilder:object:root=true
@@ -7,13 +7,15 @@ package v1alpha1
 
 // CompressorType defines the types of compressor library supported by Envoy Gateway.
 //
-// +kubebuilder:validation:Enum=Gzip;Brotli
+// +kubebuilder:validation:Enum=Gzip;Brotli;Zstd
 type CompressorType string
 
 const (
 	GzipCompressorType CompressorType = "Gzip"
 
 	BrotliCompressorType CompressorType = "Brotli"
+
+	ZstdCompressorType CompressorType = "Zstd"
 )
 
 // GzipCompressor defines the config for the Gzip compressor.
@@ -26,6 +28,11 @@ type GzipCompressor struct{}
 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/brotli/compressor/v3/brotli.proto#extension-envoy-compression-brotli-compressor
 type BrotliCompressor struct{}
 
+// ZstdCompressor defines the config for the Zstd compressor.
+// The default values can be found here:
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/zstd/compressor/v3/zstd.proto#extension-envoy-compression-zstd-compressor
+type ZstdCompressor struct{}
+
 // Compression defines the config of enabling compression.
 // This can help reduce the bandwidth at the expense of higher CPU.
 type Compression struct {
@@ -43,4 +50,28 @@ type Compression struct {
 	//
 	// +optional
 	Gzip *GzipCompressor `json:"gzip,omitempty"`
+
+	// The configuration for Zstd compressor.
+	// This is only supported by BackendTrafficPolicy.
+	//
+	// +optional
+	Zstd *ZstdCompressor `json:"zstd,omitempty"`
+
+	// MinContentLength is the minimum length of the responses, in bytes, to be compressed.
+	// The responses without a Content-Length header are always compressed.
+	// Defaults to 30 bytes.
+	// This is only supported by BackendTrafficPolicy.
+	//
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+
+	// ContentTypes is the list of the content types of the responses to be compressed.
+	// Defaults to the list of the compressor filter of Envoy, which includes the common
+	// text, JSON, XML and JavaScript content types:
+	// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/compressor/v3/compressor.proto#extensions-filters-http-compressor-v3-compressor-commondirectionconfig
+	// This is only supported by BackendTrafficPolicy.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty"`
 }
@@ -65,6 +65,12 @@ type EnvoyExtensionPolicySpec struct {
 	// +kubebuilder:validation:MaxItems=16
 	// +optional
 	Lua []Lua `json:"lua,omitempty"`
+
+	// GRPCJSONTranscoder transcodes the RESTful JSON requests into gRPC requests
+	// to the backends, and the gRPC responses back into JSON.
+	//
+	// +optional
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty"`
 }
 
 //+kubebuilder:object:root=true
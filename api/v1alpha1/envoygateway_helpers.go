@@ -96,6 +96,19 @@ func (e *EnvoyGateway) NamespaceMode() bool {
 		len(e.Provider.Kubernetes.Watch.Namespaces) > 0
 }
 
+// GetExtensionManagers returns all the registered extension managers in hook execution order:
+// the ExtensionManager first, if set, followed by the ExtensionManagers in the order they are listed.
+func (e *EnvoyGateway) GetExtensionManagers() []*ExtensionManager {
+	var managers []*ExtensionManager
+	if e.ExtensionManager != nil {
+		managers = append(managers, e.ExtensionManager)
+	}
+	for i := range e.ExtensionManagers {
+		managers = append(managers, &e.ExtensionManagers[i])
+	}
+	return managers
+}
+
 // DefaultLeaderElection returns a new LeaderElection with default configuration parameters.
 func DefaultLeaderElection() *LeaderElection {
 	return &LeaderElection{
@@ -84,6 +84,16 @@ type EnvoyGatewaySpec struct {
 	// +optional
 	ExtensionManager *ExtensionManager `json:"extensionManager,omitempty"`
 
+	// ExtensionManagers defines additional extension managers to register for the
+	// Envoy Gateway Control Plane.
+	//
+	// Hooks are executed in the order the extension managers are listed, after the
+	// ExtensionManager if one is set. The resources returned by an extension are passed
+	// as input to the next extension, so later extensions see the changes made by earlier ones.
+	//
+	// +optional
+	ExtensionManagers []ExtensionManager `json:"extensionManagers,omitempty"`
+
 	// ExtensionAPIs defines the settings related to specific Gateway API Extensions
 	// implemented by Envoy Gateway
 	//
@@ -477,6 +487,12 @@ type RateLimitRedisSettings struct {
 // ExtensionManager defines the configuration for registering an extension manager to
 // the Envoy Gateway control plane.
 type ExtensionManager struct {
+	// Name is a unique name for the extension manager. It is used to identify
+	// the extension in logs and errors when multiple extension managers are registered.
+	//
+	// +optional
+	Name string `json:"name,omitempty"`
+
 	// Resources defines the set of K8s resources the extension will handle as route
 	// filter resources
 	//
@@ -526,6 +542,11 @@ type ExtensionManager struct {
 type ExtensionHooks struct {
 	// XDSTranslator defines all the supported extension hooks for the xds-translator runner
 	XDSTranslator *XDSTranslatorHooks `json:"xdsTranslator,omitempty"`
+
+	// Infrastructure defines all the supported extension hooks for the infrastructure runner
+	//
+	// +optional
+	Infrastructure *InfrastructureHooks `json:"infrastructure,omitempty"`
 }
 
 // XDSTranslatorHooks contains all the pre and post hooks for the xds-translator runner.
@@ -534,6 +555,11 @@ type XDSTranslatorHooks struct {
 	Post []XDSTranslatorHook `json:"post,omitempty"`
 }
 
+// InfrastructureHooks contains all the post hooks for the infrastructure runner.
+type InfrastructureHooks struct {
+	Post []InfrastructureHook `json:"post,omitempty"`
+}
+
 // ExtensionService defines the configuration for connecting to a registered extension service.
 type ExtensionService struct {
 	// BackendEndpoint points to where the extension server can be found.
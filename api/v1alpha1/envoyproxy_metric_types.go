@@ -9,6 +9,7 @@ type MetricSinkType string
 
 const (
 	MetricSinkTypeOpenTelemetry MetricSinkType = "OpenTelemetry"
+	MetricSinkTypeStatsD        MetricSinkType = "StatsD"
 )
 
 type ProxyMetrics struct {
@@ -31,6 +32,14 @@ type ProxyMetrics struct {
 	// +optional
 	EnableVirtualHostStats *bool `json:"enableVirtualHostStats,omitempty"`
 
+	// EnableRouteStats enables envoy stat metrics for routes.
+	// The stats of each route are emitted under `vhost.<virtual host name>.route.<route name>`,
+	// where the route name encodes the kind, namespace and name of the xRoute, and the index of the rule and match.
+	// Please use with caution.
+	//
+	// +optional
+	EnableRouteStats *bool `json:"enableRouteStats,omitempty"`
+
 	// EnablePerEndpointStats enables per endpoint envoy stats metrics.
 	// Please use with caution.
 	//
@@ -48,10 +57,11 @@ type ProxyMetrics struct {
 // +union
 //
 // +kubebuilder:validation:XValidation:rule="self.type == 'OpenTelemetry' ? has(self.openTelemetry) : !has(self.openTelemetry)",message="If MetricSink type is OpenTelemetry, openTelemetry field needs to be set."
+// +kubebuilder:validation:XValidation:rule="self.type == 'StatsD' ? has(self.statsD) : !has(self.statsD)",message="If MetricSink type is StatsD, statsD field needs to be set."
 type ProxyMetricSink struct {
 	// Type defines the metric sink type.
-	// EG currently only supports OpenTelemetry.
-	// +kubebuilder:validation:Enum=OpenTelemetry
+	// EG currently supports OpenTelemetry and StatsD.
+	// +kubebuilder:validation:Enum=OpenTelemetry;StatsD
 	// +kubebuilder:default=OpenTelemetry
 	// +unionDiscriminator
 	Type MetricSinkType `json:"type"`
@@ -59,6 +69,10 @@ type ProxyMetricSink struct {
 	// It's required if the sink type is OpenTelemetry.
 	// +optional
 	OpenTelemetry *ProxyOpenTelemetrySink `json:"openTelemetry,omitempty"`
+	// StatsD defines the configuration for StatsD sink.
+	// It's required if the sink type is StatsD.
+	// +optional
+	StatsD *ProxyStatsDSink `json:"statsD,omitempty"`
 }
 
 // ProxyOpenTelemetrySink defines the configuration for OpenTelemetry sink.
@@ -86,10 +100,30 @@ type ProxyOpenTelemetrySink struct {
 	// TODO: add support for customizing OpenTelemetry sink in https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/stat_sinks/open_telemetry/v3/open_telemetry.proto#envoy-v3-api-msg-extensions-stat-sinks-open-telemetry-v3-sinkconfig
 }
 
+// ProxyStatsDSink defines the configuration for StatsD sink.
+// The metrics are sent over TCP to the StatsD compliant listener.
+//
+// +kubebuilder:validation:XValidation:message="backendRefs needs to be set",rule="has(self.backendRefs) && self.backendRefs.size() > 0"
+// +kubebuilder:validation:XValidation:message="BackendRefs must be used, backendRef is not supported.",rule="!has(self.backendRef)"
+// +kubebuilder:validation:XValidation:message="only supports Service kind.",rule="has(self.backendRefs) ? self.backendRefs.all(f, f.kind == 'Service') : true"
+// +kubebuilder:validation:XValidation:message="BackendRefs only supports Core group.",rule="has(self.backendRefs) ? (self.backendRefs.all(f, f.group == \"\")) : true"
+type ProxyStatsDSink struct {
+	BackendCluster `json:",inline"`
+	// Prefix is added to the names of the stats sent to the sink.
+	// Defaults to "envoy".
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Prefix *string `json:"prefix,omitempty"`
+}
+
 type ProxyPrometheusProvider struct {
 	// Disable the Prometheus endpoint.
 	Disable bool `json:"disable,omitempty"`
 	// Configure the compression on Prometheus endpoint. Compression is useful in situations when bandwidth is scarce and large payloads can be effectively compressed at the expense of higher CPU load.
+	// The Zstd compressor, minContentLength and contentTypes are not supported on the Prometheus endpoint.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.type != 'Zstd' && !has(self.zstd)",message="Zstd compressor is not supported on the Prometheus endpoint"
+	// +kubebuilder:validation:XValidation:rule="!has(self.minContentLength) && !has(self.contentTypes)",message="minContentLength and contentTypes are not supported on the Prometheus endpoint"
 	Compression *Compression `json:"compression,omitempty"`
 }
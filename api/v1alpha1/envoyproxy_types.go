@@ -100,6 +100,10 @@ type EnvoyProxySpec struct {
 	//
 	// - envoy.filters.http.health_check
 	//
+	// - envoy.filters.http.ip_tagging
+	//
+	// - envoy.filters.http.json_to_metadata
+	//
 	// - envoy.filters.http.fault
 	//
 	// - envoy.filters.http.cors
@@ -128,6 +132,12 @@ type EnvoyProxySpec struct {
 	//
 	// - envoy.filters.http.custom_response
 	//
+	// - envoy.filters.http.cache
+	//
+	// - envoy.filters.http.grpc_json_transcoder
+	//
+	// - envoy.filters.http.credential_injector
+	//
 	// - envoy.filters.http.router
 	//
 	// Note: "envoy.filters.http.router" cannot be reordered, it's always the last filter in the chain.
@@ -197,13 +207,19 @@ type FilterPosition struct {
 }
 
 // EnvoyFilter defines the type of Envoy HTTP filter.
-// +kubebuilder:validation:Enum=envoy.filters.http.health_check;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.ext_authz;envoy.filters.http.api_key_auth;envoy.filters.http.basic_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.lua;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.custom_response;envoy.filters.http.compressor
+// +kubebuilder:validation:Enum=envoy.filters.http.health_check;envoy.filters.http.ip_tagging;envoy.filters.http.json_to_metadata;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.ext_authz;envoy.filters.http.api_key_auth;envoy.filters.http.basic_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.lua;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.custom_response;envoy.filters.http.compressor;envoy.filters.http.cache;envoy.filters.http.grpc_json_transcoder;envoy.filters.http.credential_injector
 type EnvoyFilter string
 
 const (
 	// EnvoyFilterHealthCheck defines the Envoy HTTP health check filter.
 	EnvoyFilterHealthCheck EnvoyFilter = "envoy.filters.http.health_check"
 
+	// EnvoyFilterIPTagging defines the Envoy HTTP IP tagging filter.
+	EnvoyFilterIPTagging EnvoyFilter = "envoy.filters.http.ip_tagging"
+
+	// EnvoyFilterJSONToMetadata defines the Envoy HTTP JSON to metadata filter.
+	EnvoyFilterJSONToMetadata EnvoyFilter = "envoy.filters.http.json_to_metadata"
+
 	// EnvoyFilterFault defines the Envoy HTTP fault filter.
 	EnvoyFilterFault EnvoyFilter = "envoy.filters.http.fault"
 
@@ -253,6 +269,15 @@ const (
 	// EnvoyFilterCompressor defines the Envoy HTTP compressor filter.
 	EnvoyFilterCompressor EnvoyFilter = "envoy.filters.http.compressor"
 
+	// EnvoyFilterCache defines the Envoy HTTP cache filter.
+	EnvoyFilterCache EnvoyFilter = "envoy.filters.http.cache"
+
+	// EnvoyFilterGRPCJSONTranscoder defines the Envoy HTTP gRPC-JSON transcoder filter.
+	EnvoyFilterGRPCJSONTranscoder EnvoyFilter = "envoy.filters.http.grpc_json_transcoder"
+
+	// EnvoyFilterCredentialInjector defines the Envoy HTTP credential injector filter.
+	EnvoyFilterCredentialInjector EnvoyFilter = "envoy.filters.http.credential_injector"
+
 	// EnvoyFilterRouter defines the Envoy HTTP router filter.
 	EnvoyFilterRouter EnvoyFilter = "envoy.filters.http.router"
 )
@@ -305,6 +330,7 @@ type ShutdownConfig struct {
 
 // +kubebuilder:validation:XValidation:rule="((has(self.envoyDeployment) && !has(self.envoyDaemonSet)) || (!has(self.envoyDeployment) && has(self.envoyDaemonSet))) || (!has(self.envoyDeployment) && !has(self.envoyDaemonSet))",message="only one of envoyDeployment or envoyDaemonSet can be specified"
 // +kubebuilder:validation:XValidation:rule="((has(self.envoyHpa) && !has(self.envoyDaemonSet)) || (!has(self.envoyHpa) && has(self.envoyDaemonSet))) || (!has(self.envoyHpa) && !has(self.envoyDaemonSet))",message="cannot use envoyHpa if envoyDaemonSet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.envoyDeployment) || !has(self.envoyDeployment.pod) || !has(self.envoyDeployment.pod.hostNetwork) || !self.envoyDeployment.pod.hostNetwork || ((!has(self.envoyDeployment.replicas) || self.envoyDeployment.replicas <= 1) && !has(self.envoyHpa))",message="hostNetwork cannot be used with more than one replica of envoyDeployment, use envoyDaemonSet to run one Envoy pod per node"
 //
 // EnvoyProxyKubernetesProvider defines configuration for the Kubernetes resource
 // provider.
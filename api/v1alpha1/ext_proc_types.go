@@ -9,7 +9,7 @@ import (
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
-// +kubebuilder:validation:Enum=Streamed;Buffered;BufferedPartial
+// +kubebuilder:validation:Enum=Streamed;Buffered;BufferedPartial;FullDuplexStreamed
 type ExtProcBodyProcessingMode string
 
 const (
@@ -19,6 +19,10 @@ const (
 	BufferedExtProcBodyProcessingMode ExtProcBodyProcessingMode = "Buffered"
 	// BufferedPartialExtBodyHeaderProcessingMode will buffer the message body in memory and send the entire body in one chunk. If the body exceeds the configured buffer limit, then the body contents up to the buffer limit will be sent.
 	BufferedPartialExtBodyHeaderProcessingMode ExtProcBodyProcessingMode = "BufferedPartial"
+	// FullDuplexStreamedExtProcBodyProcessingMode will stream the body to the server in pieces as they arrive at the proxy,
+	// and stream back the mutated body from the server independently of the received pieces, so the server can
+	// transform the whole body without buffering it in the proxy. The trailers are sent to the server in this mode.
+	FullDuplexStreamedExtProcBodyProcessingMode ExtProcBodyProcessingMode = "FullDuplexStreamed"
 )
 
 // ProcessingModeOptions defines if headers or body should be processed by the external service
@@ -74,6 +78,15 @@ type ExtProc struct {
 	// +optional
 	MessageTimeout *gwapiv1.Duration `json:"messageTimeout,omitempty"`
 
+	// MaxMessageTimeout is the maximum timeout the external processor can request, with the
+	// `override_message_timeout` field of its response, to extend the timeout of the current message.
+	// This lets processors transforming large bodies ask for more time when needed, while keeping
+	// a short MessageTimeout for the other messages.
+	// Default: the external processor cannot override the message timeout
+	//
+	// +optional
+	MaxMessageTimeout *gwapiv1.Duration `json:"maxMessageTimeout,omitempty"`
+
 	// FailOpen defines if requests or responses that cannot be processed due to connectivity to the
 	// external processor are terminated or passed-through.
 	// Default: false
@@ -0,0 +1,72 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// ProtoDescriptorValueType defines the types of values for the proto descriptor set supported by Envoy Gateway.
+// +kubebuilder:validation:Enum=Inline;ValueRef
+type ProtoDescriptorValueType string
+
+const (
+	// ProtoDescriptorValueTypeInline defines the "Inline" proto descriptor set type.
+	ProtoDescriptorValueTypeInline ProtoDescriptorValueType = "Inline"
+
+	// ProtoDescriptorValueTypeValueRef defines the "ValueRef" proto descriptor set type.
+	ProtoDescriptorValueTypeValueRef ProtoDescriptorValueType = "ValueRef"
+)
+
+// ProtoDescriptorSetKey is the key of the proto descriptor set in the binaryData of the ConfigMap.
+const ProtoDescriptorSetKey = "descriptor.pb"
+
+// GRPCJSONTranscoder defines the configuration of the gRPC-JSON transcoder, which
+// transcodes the RESTful JSON requests into gRPC requests to the backends, and the
+// gRPC responses back into JSON.
+//
+// The requests are mapped to the methods of the gRPC services according to the
+// google.api.http annotations of the methods. The routes keep being selected with
+// the original path of the requests, and the backends must support HTTP/2.
+type GRPCJSONTranscoder struct {
+	// ProtoDescriptor is the binary proto descriptor set of the gRPC services, generated
+	// by protoc with the --include_imports and --descriptor_set_out flags.
+	ProtoDescriptor ProtoDescriptor `json:"protoDescriptor"`
+
+	// Services is the list of the fully qualified names of the gRPC services to
+	// transcode, for example `bookstore.Bookstore`. The services must be defined
+	// in the proto descriptor set.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Services []string `json:"services"`
+}
+
+// ProtoDescriptor defines the source of a binary proto descriptor set.
+// Only one of Inline or ValueRef must be set
+//
+// +kubebuilder:validation:XValidation:rule="(self.type == 'Inline' && has(self.inline) && !has(self.valueRef)) || (self.type == 'ValueRef' && !has(self.inline) && has(self.valueRef))",message="Exactly one of inline or valueRef must be set with correct type."
+type ProtoDescriptor struct {
+	// Type is the type of method to use to read the proto descriptor set.
+	// Valid values are Inline and ValueRef, default is Inline.
+	//
+	// +kubebuilder:default=Inline
+	// +unionDiscriminator
+	// +required
+	Type ProtoDescriptorValueType `json:"type"`
+	// Inline contains the proto descriptor set encoded in base64.
+	//
+	// +optional
+	// +unionMember
+	Inline *string `json:"inline,omitempty"`
+	// ValueRef has the proto descriptor set specified as a local object reference.
+	// Only a reference to ConfigMap is supported.
+	// The value of key `descriptor.pb` in the binaryData of the ConfigMap will be used.
+	// If the key is not found, the first value in the binaryData of the ConfigMap will be used.
+	//
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'ConfigMap' && (self.group == 'v1' || self.group == '')",message="Only a reference to an object of kind ConfigMap belonging to default v1 API group is supported."
+	// +optional
+	// +unionMember
+	ValueRef *gwapiv1.LocalObjectReference `json:"valueRef,omitempty"`
+}
@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -35,6 +36,21 @@ type HTTPRouteFilterSpec struct {
 	URLRewrite *HTTPURLRewriteFilter `json:"urlRewrite,omitempty"`
 	// +optional
 	DirectResponse *HTTPDirectResponseFilter `json:"directResponse,omitempty"`
+	// BodyMatch restricts the HTTPRouteRule using this filter to the requests
+	// whose JSON body has a field matching the given value.
+	// It can be used to route requests based on the content of their body,
+	// such as the operation name of a GraphQL query.
+	//
+	// +optional
+	BodyMatch *HTTPBodyMatch `json:"bodyMatch,omitempty"`
+	// CredentialInjection injects a credential stored in a Secret in the requests
+	// sent to the backends. The credential is never exposed to the clients.
+	//
+	// The credential is delivered to Envoy over SDS. This filter can only be
+	// referenced from the HTTPRouteRule filters, not from the filters of a backendRef.
+	//
+	// +optional
+	CredentialInjection *HTTPCredentialInjectionFilter `json:"credentialInjection,omitempty"`
 }
 
 // HTTPURLRewriteFilter define rewrites of HTTP URL components such as path and host
@@ -68,6 +84,71 @@ type HTTPDirectResponseFilter struct {
 	StatusCode *int `json:"statusCode,omitempty"`
 }
 
+// HTTPBodyMatch defines a match condition on a field of the JSON request body.
+//
+// The request body is buffered up to the buffer limit of the connection before
+// the route is selected. Only requests with the application/json content type are
+// inspected, the other requests never match.
+type HTTPBodyMatch struct {
+	// JSONPath is the path of the field in the JSON request body, with the keys
+	// of the nested objects separated by dots, for example `operationName` or
+	// `header.action`. Array indexes are not supported.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[^.]+(\.[^.]+)*$`
+	JSONPath string `json:"jsonPath"`
+
+	// Value defines how to match the value of the field.
+	// Number and boolean fields are matched against their string representation.
+	Value StringMatch `json:"value"`
+}
+
+// CredentialInjectionType defines the type of the injected credential.
+// +kubebuilder:validation:Enum=APIKey;Bearer;Basic
+type CredentialInjectionType string
+
+const (
+	// APIKeyCredentialInjectionType injects the credential as is, in the X-API-Key header by default.
+	APIKeyCredentialInjectionType CredentialInjectionType = "APIKey"
+	// BearerCredentialInjectionType injects the credential as a bearer token, in the Authorization header by default.
+	BearerCredentialInjectionType CredentialInjectionType = "Bearer"
+	// BasicCredentialInjectionType injects the username and the password of the basic authentication scheme,
+	// in the Authorization header by default.
+	BasicCredentialInjectionType CredentialInjectionType = "Basic"
+)
+
+// CredentialInjectionSecretKey is the key of the credential in the Secret,
+// for the APIKey and Bearer types.
+const CredentialInjectionSecretKey = "credential"
+
+// HTTPCredentialInjectionFilter defines the configuration to inject a credential
+// in the requests sent to the backends.
+//
+// The header of the credential sent by the client, if any, is overwritten.
+type HTTPCredentialInjectionFilter struct {
+	// Type defines the type of the credential.
+	Type CredentialInjectionType `json:"type"`
+
+	// Header is the name of the request header the credential is injected in.
+	// Defaults to X-API-Key for the APIKey type, and to Authorization for the
+	// Bearer and Basic types.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Header *string `json:"header,omitempty"`
+
+	// SecretRef is the reference to the Secret containing the credential.
+	// For the APIKey and Bearer types, the credential is stored in the "credential" key.
+	// For the Basic type, the username and the password are stored in the "username"
+	// and "password" keys, like in the Secrets of the kubernetes.io/basic-auth type.
+	//
+	// The Secret can be in another namespace than the HTTPRouteFilter, if a
+	// ReferenceGrant allows the HTTPRouteFilter to reference it.
+	SecretRef gwapiv1.SecretObjectReference `json:"secretRef"`
+}
+
 // HTTPPathModifierType defines the type of path redirect or rewrite.
 type HTTPPathModifierType string
 
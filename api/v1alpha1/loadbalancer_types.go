@@ -12,6 +12,7 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 //
 // +kubebuilder:validation:XValidation:rule="self.type == 'ConsistentHash' ? has(self.consistentHash) : !has(self.consistentHash)",message="If LoadBalancer type is consistentHash, consistentHash field needs to be set."
 // +kubebuilder:validation:XValidation:rule="self.type in ['Random', 'ConsistentHash'] ? !has(self.slowStart) : true ",message="Currently SlowStart is only supported for RoundRobin and LeastRequest load balancers."
+// +kubebuilder:validation:XValidation:rule="self.type == 'LeastRequest' ? true : !has(self.leastRequest)",message="LeastRequest can only be set when the LoadBalancer type is LeastRequest."
 type LoadBalancer struct {
 	// Type decides the type of Load Balancer policy.
 	// Valid LoadBalancerType values are
@@ -28,6 +29,12 @@ type LoadBalancer struct {
 	// +optional
 	ConsistentHash *ConsistentHash `json:"consistentHash,omitempty"`
 
+	// LeastRequest defines the configuration when the load balancer type is
+	// set to LeastRequest
+	//
+	// +optional
+	LeastRequest *LeastRequest `json:"leastRequest,omitempty"`
+
 	// SlowStart defines the configuration related to the slow start load balancer policy.
 	// If set, during slow start window, traffic sent to the newly added hosts will gradually increase.
 	// Currently this is only supported for RoundRobin and LeastRequest load balancers
@@ -51,17 +58,31 @@ const (
 	RoundRobinLoadBalancerType LoadBalancerType = "RoundRobin"
 )
 
+// LeastRequest defines the configuration related to the least request
+// load balancer policy.
+type LeastRequest struct {
+	// ChoiceCount is the number of random hosts picked by the load balancer, among which
+	// the host with the fewest active requests is chosen.
+	// Default: 2.
+	//
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	ChoiceCount *uint32 `json:"choiceCount,omitempty"`
+}
+
 // ConsistentHash defines the configuration related to the consistent hash
 // load balancer policy.
 // +union
 //
 // +kubebuilder:validation:XValidation:rule="self.type == 'Header' ? has(self.header) : !has(self.header)",message="If consistent hash type is header, the header field must be set."
 // +kubebuilder:validation:XValidation:rule="self.type == 'Cookie' ? has(self.cookie) : !has(self.cookie)",message="If consistent hash type is cookie, the cookie field must be set."
+// +kubebuilder:validation:XValidation:rule="self.type == 'QueryParameter' ? has(self.queryParameter) : !has(self.queryParameter)",message="If consistent hash type is queryParameter, the queryParameter field must be set."
 type ConsistentHash struct {
 	// ConsistentHashType defines the type of input to hash on. Valid Type values are
 	// "SourceIP",
 	// "Header",
-	// "Cookie".
+	// "Cookie",
+	// "QueryParameter".
 	//
 	// +unionDiscriminator
 	Type ConsistentHashType `json:"type"`
@@ -76,7 +97,22 @@ type ConsistentHash struct {
 	// +optional
 	Cookie *Cookie `json:"cookie,omitempty"`
 
+	// QueryParameter configures the query parameter hash policy when the consistent hash type is set to QueryParameter.
+	//
+	// +optional
+	QueryParameter *QueryParameter `json:"queryParameter,omitempty"`
+
+	// Algorithm defines the consistent hashing algorithm used to select the backend hosts.
+	// Valid Algorithm values are
+	// "Maglev",
+	// "RingHash".
+	// Default: Maglev.
+	//
+	// +optional
+	Algorithm *ConsistentHashAlgorithm `json:"algorithm,omitempty"`
+
 	// The table size for consistent hashing, must be prime number limited to 5000011.
+	// The table size only applies to the Maglev algorithm.
 	//
 	// +kubebuilder:validation:Minimum=2
 	// +kubebuilder:validation:Maximum=5000011
@@ -85,6 +121,17 @@ type ConsistentHash struct {
 	TableSize *uint64 `json:"tableSize,omitempty"`
 }
 
+// ConsistentHashAlgorithm defines the consistent hashing algorithm.
+// +kubebuilder:validation:Enum=Maglev;RingHash
+type ConsistentHashAlgorithm string
+
+const (
+	// MaglevConsistentHashAlgorithm selects the backend hosts with a Maglev lookup table.
+	MaglevConsistentHashAlgorithm ConsistentHashAlgorithm = "Maglev"
+	// RingHashConsistentHashAlgorithm selects the backend hosts with a Ketama hash ring.
+	RingHashConsistentHashAlgorithm ConsistentHashAlgorithm = "RingHash"
+)
+
 // Header defines the header hashing configuration for consistent hash based
 // load balancing.
 type Header struct {
@@ -110,10 +157,22 @@ type Cookie struct {
 	//
 	// +optional
 	Attributes map[string]string `json:"attributes,omitempty"`
+	// Path of the generated cookie. This value sets the Path attribute value.
+	//
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// QueryParameter defines the query parameter hashing configuration for consistent hash based
+// load balancing.
+type QueryParameter struct {
+	// Name of the query parameter to hash.
+	// If this query parameter does not exist in the request, the request is not hashed.
+	Name string `json:"name"`
 }
 
 // ConsistentHashType defines the type of input to hash on.
-// +kubebuilder:validation:Enum=SourceIP;Header;Cookie
+// +kubebuilder:validation:Enum=SourceIP;Header;Cookie;QueryParameter
 type ConsistentHashType string
 
 const (
@@ -123,15 +182,25 @@ const (
 	HeaderConsistentHashType ConsistentHashType = "Header"
 	// CookieConsistentHashType hashes based on a cookie.
 	CookieConsistentHashType ConsistentHashType = "Cookie"
+	// QueryParameterConsistentHashType hashes based on a query parameter.
+	QueryParameterConsistentHashType ConsistentHashType = "QueryParameter"
 )
 
 // SlowStart defines the configuration related to the slow start load balancer policy.
 type SlowStart struct {
 	// Window defines the duration of the warm up period for newly added host.
 	// During slow start window, traffic sent to the newly added hosts will gradually increase.
-	// Currently only supports linear growth of traffic. For additional details,
+	// The growth of traffic is linear by default, see Aggression. For additional details,
 	// see https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/cluster.proto#config-cluster-v3-cluster-slowstartconfig
 	// +kubebuilder:validation:Required
 	Window *metav1.Duration `json:"window"`
-	// TODO: Add support for non-linear traffic increases based on user usage.
+	// Aggression controls the rate at which the traffic sent to the newly added hosts increases
+	// during the slow start window. The traffic increases linearly when set to 1, more quickly at
+	// the beginning of the window when greater than 1, and more slowly when less than 1.
+	// Default: 1.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:ExclusiveMinimum=true
+	// +optional
+	Aggression *float32 `json:"aggression,omitempty"`
 }
@@ -0,0 +1,24 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+// ProtocolUpgradeConfig defines the configuration of an HTTP protocol upgrade.
+type ProtocolUpgradeConfig struct {
+	// Type is the case-insensitive name of the protocol of the upgrade, as sent
+	// in the Upgrade header of the requests, for example `websocket` or `spdy/3.1`.
+	// The CONNECT requests are not upgrades, they are terminated by the HTTPRoute
+	// rules matching the CONNECT method.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Type string `json:"type"`
+
+	// Disabled rejects the upgrades to this protocol. It can be used to reject the
+	// WebSocket upgrades, which are allowed by default.
+	//
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
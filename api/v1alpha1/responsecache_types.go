@@ -0,0 +1,67 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ResponseCacheType defines the types of storage of the cached responses.
+//
+// +kubebuilder:validation:Enum=Memory
+type ResponseCacheType string
+
+const (
+	// MemoryResponseCacheType stores the cached responses in the memory of the Envoy proxy.
+	MemoryResponseCacheType ResponseCacheType = "Memory"
+)
+
+// ResponseCache defines the configuration of the HTTP response cache.
+// The responses are cached according to the HTTP caching rules of RFC 7234,
+// based on the Cache-Control, Expires and Vary headers of the requests and the responses.
+// The default values can be found here:
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/cache_filter
+type ResponseCache struct {
+	// Type defines the storage of the cached responses.
+	// Only Memory is supported, the cached responses are stored in the memory of each
+	// Envoy proxy and are not shared between the replicas.
+	//
+	// +kubebuilder:default=Memory
+	// +optional
+	Type *ResponseCacheType `json:"type,omitempty"`
+
+	// Key defines the parts of the request included in the cache key,
+	// in addition to the scheme, the host and the path.
+	//
+	// +optional
+	Key *ResponseCacheKey `json:"key,omitempty"`
+
+	// TTL defines the freshness lifetime of the responses without a Cache-Control header.
+	// A `Cache-Control: max-age=<TTL>` header is added to these responses. The Cache-Control
+	// header set by the backend is kept, so the private or no-store responses are not cached.
+	// The TTL must be at least 1s.
+	//
+	// +optional
+	TTL *gwapiv1.Duration `json:"ttl,omitempty"`
+}
+
+// ResponseCacheKey defines the parts of the request included in the cache key.
+type ResponseCacheKey struct {
+	// Headers is the list of the request headers the cached responses can vary on.
+	// The responses with a Vary header listing other headers are not cached.
+	// The values of the headers listed in the Vary header of a response are part of its cache key.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Headers []string `json:"headers,omitempty"`
+
+	// QueryParams is the list of the query parameters included in the cache key.
+	// If unset, all the query parameters are included in the cache key.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	QueryParams []string `json:"queryParams,omitempty"`
+}
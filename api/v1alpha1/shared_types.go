@@ -182,6 +182,16 @@ type KubernetesPodSpec struct {
 	//
 	// +optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// HostNetwork runs the pod in the host network namespace, so that it can be reached
+	// on the ports of the node it runs on. The DNS policy of the pod is then set to
+	// ClusterFirstWithHostNet.
+	// Only one pod can run on the host network of a node, so a Deployment on the host network
+	// is limited to one replica.
+	// Defaults to false.
+	//
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
 }
 
 // KubernetesContainerSpec defines the desired state of the Kubernetes container resource.
@@ -360,6 +370,19 @@ const (
 	XDSTranslation  XDSTranslatorHook = "Translation"
 )
 
+// InfrastructureHook defines the types of hooks that an Envoy Gateway extension may support
+// for the infrastructure runner
+//
+// +kubebuilder:validation:Enum=Resources
+type InfrastructureHook string
+
+const (
+	// InfrastructureResources allows an extension to modify the Kubernetes resources
+	// (e.g. Deployment, Service, HorizontalPodAutoscaler) generated for an Envoy Proxy
+	// fleet before they are applied.
+	InfrastructureResources InfrastructureHook = "Resources"
+)
+
 // StringMatch defines how to match any strings.
 // This is a general purpose match condition that can be used by other EG APIs
 // that need to match against a string.
@@ -532,6 +555,7 @@ type BackendCluster struct {
 	// to the backend.
 	//
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.timeout) || !has(self.timeout.http) || !has(self.timeout.http.streamIdleTimeout)",message="streamIdleTimeout is only supported by BackendTrafficPolicy"
 	BackendSettings *ClusterSettings `json:"backendSettings,omitempty"`
 }
 
@@ -627,6 +651,27 @@ type HTTP2Settings struct {
 	// +optional
 	MaxConcurrentStreams *uint32 `json:"maxConcurrentStreams,omitempty"`
 
+	// MaxOutboundFrames limits the number of pending outbound frames of all types on a connection.
+	// The connection is closed when the limit is reached, to protect against the flooding of frames.
+	// If not set, the default value is 10000.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxOutboundFrames *uint32 `json:"maxOutboundFrames,omitempty"`
+
+	// MaxOutboundControlFrames limits the number of pending outbound PING, SETTINGS and RST_STREAM
+	// frames on a connection. The connection is closed when the limit is reached.
+	// If not set, the default value is 1000.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxOutboundControlFrames *uint32 `json:"maxOutboundControlFrames,omitempty"`
+
+	// MaxConsecutiveInboundFramesWithEmptyPayload limits the number of consecutive inbound frames
+	// with an empty payload and without the end stream flag on a connection. The connection is
+	// closed when the limit is exceeded.
+	// If not set, the default value is 1.
+	// +optional
+	MaxConsecutiveInboundFramesWithEmptyPayload *uint32 `json:"maxConsecutiveInboundFramesWithEmptyPayload,omitempty"`
+
 	// OnInvalidMessage determines if Envoy will terminate the connection or just the offending stream in the event of HTTP messaging error
 	// It's recommended for L2 Envoy deployments to set this value to TerminateStream.
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/best_practices/level_two
@@ -644,13 +689,44 @@ type ResponseOverride struct {
 }
 
 // CustomResponseMatch defines the configuration for matching a user response to return a custom one.
+// +kubebuilder:validation:XValidation:message="at least one of statusCodes or failures must be set",rule="has(self.statusCodes) || has(self.failures)"
 type CustomResponseMatch struct {
 	// Status code to match on. The match evaluates to true if any of the matches are successful.
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=50
-	StatusCodes []StatusCodeMatch `json:"statusCodes"`
+	// +optional
+	StatusCodes []StatusCodeMatch `json:"statusCodes,omitempty"`
+
+	// Failures to match on. The match evaluates to true if the response was generated by Envoy
+	// because of any of the failures, instead of being returned by the backend.
+	// When both StatusCodes and Failures are set, the match evaluates to true if any of them is successful.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	Failures []ResponseFailureType `json:"failures,omitempty"`
 }
 
+// ResponseFailureType defines the types of upstream failures that can be matched to return a custom response.
+// +kubebuilder:validation:Enum=UpstreamTimeout;NoHealthyUpstream;UpstreamOverflow;UpstreamConnectionFailure
+type ResponseFailureType string
+
+const (
+	// ResponseFailureTypeUpstreamTimeout matches the responses returned when the request to the backend timed out.
+	ResponseFailureTypeUpstreamTimeout ResponseFailureType = "UpstreamTimeout"
+
+	// ResponseFailureTypeNoHealthyUpstream matches the responses returned when the backend had no healthy endpoint.
+	ResponseFailureTypeNoHealthyUpstream ResponseFailureType = "NoHealthyUpstream"
+
+	// ResponseFailureTypeUpstreamOverflow matches the responses returned when the request was rejected
+	// because a circuit breaker of the backend was open.
+	ResponseFailureTypeUpstreamOverflow ResponseFailureType = "UpstreamOverflow"
+
+	// ResponseFailureTypeUpstreamConnectionFailure matches the responses returned when the connection
+	// to the backend failed.
+	ResponseFailureTypeUpstreamConnectionFailure ResponseFailureType = "UpstreamConnectionFailure"
+)
+
 // StatusCodeValueType defines the types of values for the status code match supported by Envoy Gateway.
 // +kubebuilder:validation:Enum=Value;Range
 type StatusCodeValueType string
@@ -712,6 +788,14 @@ type CustomResponse struct {
 	//
 	// +optional
 	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Templated enables the substitution of the Envoy command operators in the body of the custom response,
+	// for example `%REQ(X-REQUEST-ID)%` or `%START_TIME%`, so that the failure can be correlated with the access logs.
+	// https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#command-operators
+	// Default: false
+	//
+	// +optional
+	Templated *bool `json:"templated,omitempty"`
 }
 
 // ResponseValueType defines the types of values for the response body supported by Envoy Gateway.
@@ -0,0 +1,18 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+// TCPTunneling defines the configuration of the tunneling of the TCP connections
+// to the backends in HTTP CONNECT requests, for example to reach the destinations
+// through an HTTP proxy.
+type TCPTunneling struct {
+	// Hostname is the authority sent in the CONNECT requests to the backends,
+	// usually the host and port of the destination, for example `example.com:443`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Hostname string `json:"hostname"`
+}
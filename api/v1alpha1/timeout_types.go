@@ -45,6 +45,16 @@ type HTTPTimeout struct {
 	//
 	// +optional
 	RequestTimeout *gwapiv1.Duration `json:"requestTimeout,omitempty" yaml:"requestTimeout,omitempty"`
+
+	// StreamIdleTimeout is the idle timeout of the HTTP streams of the route, which overrides the
+	// stream idle timeout of the listener. Idle time is defined as a period in which no bytes are
+	// sent or received on the stream.
+	// Default: the request timeout of the route if it is longer than 1 hour, 1 hour otherwise,
+	// or the stream idle timeout of the listener if the route has no request timeout.
+	// This is only supported by BackendTrafficPolicy.
+	//
+	// +optional
+	StreamIdleTimeout *gwapiv1.Duration `json:"streamIdleTimeout,omitempty" yaml:"streamIdleTimeout,omitempty"`
 }
 
 type ClientTimeout struct {
@@ -81,4 +91,11 @@ type HTTPClientTimeout struct {
 	//
 	// +optional
 	IdleTimeout *gwapiv1.Duration `json:"idleTimeout,omitempty"`
+
+	// StreamIdleTimeout for an HTTP stream. Idle time is defined as a period in which no bytes are sent or received
+	// on the stream, for example on a long-lived gRPC stream.
+	// Default: 5 minutes.
+	//
+	// +optional
+	StreamIdleTimeout *gwapiv1.Duration `json:"streamIdleTimeout,omitempty"`
 }
@@ -141,6 +141,35 @@ type ClientValidationContext struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +optional
 	CACertificateRefs []gwapiv1.SecretObjectReference `json:"caCertificateRefs,omitempty"`
+
+	// Crl specifies the certificate revocation lists used to check the revocation
+	// of the certificates presented by the client.
+	//
+	// +optional
+	Crl *CrlContext `json:"crl,omitempty"`
+}
+
+// CrlContext holds the certificate revocation lists used to validate the client
+// initiating the TLS connection.
+type CrlContext struct {
+	// Refs contains one or more references to a Kubernetes ConfigMap or a
+	// Kubernetes Secret, with the certificate revocation list in PEM format
+	// in a key named `ca.crl`.
+	//
+	// References to a resource in different namespace are invalid UNLESS there
+	// is a ReferenceGrant in the target namespace that allows the certificate
+	// revocation list to be attached.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Refs []gwapiv1.SecretObjectReference `json:"refs"`
+
+	// OnlyVerifyLeafCertificate set to true only checks the certificate at the end
+	// of the certificate chain against the certificate revocation lists.
+	// Defaults to false, which checks the entire certificate chain.
+	//
+	// +optional
+	OnlyVerifyLeafCertificate *bool `json:"onlyVerifyLeafCertificate,omitempty"`
 }
 
 // Session defines settings related to TLS session management.
@@ -173,9 +202,27 @@ type SessionResumption struct {
 type StatefulTLSSessionResumption struct{}
 
 // StatelessTLSSessionResumption defines the stateless (session-ticket based) type of TLS session resumption.
-// Note: When Envoy Proxy is deployed with more than one replica, session ticket encryption keys are not
-// synchronized between instances, possibly leading to resumption failures.
+// Note: When Envoy Proxy is deployed with more than one replica and no session ticket keys are set,
+// session ticket encryption keys are not synchronized between instances, possibly leading to resumption failures.
 // In-memory session ticket encryption keys are rotated every 48 hours.
 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/tls/v3/common.proto#extensions-transport-sockets-tls-v3-tlssessionticketkeys
 // https://commondatastorage.googleapis.com/chromium-boringssl-docs/ssl.h.html#Session-tickets
-type StatelessTLSSessionResumption struct{}
+type StatelessTLSSessionResumption struct {
+	// SessionTicketKeysRefs contains references to the Kubernetes Secrets holding
+	// the session ticket encryption keys, in a key named `ticket.key`.
+	// Each key must be 80 bytes long, and can be generated with
+	// `openssl rand 80`.
+	//
+	// The key of the first Secret encrypts the new session tickets, and the keys
+	// of all the Secrets decrypt the session tickets. The keys are rotated by
+	// adding a new Secret at the beginning of the list, and removing the last
+	// Secret once the session tickets it encrypted have expired.
+	//
+	// References to a resource in different namespace are invalid UNLESS there
+	// is a ReferenceGrant in the target namespace that allows the secret
+	// to be attached.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	SessionTicketKeysRefs []gwapiv1.SecretObjectReference `json:"sessionTicketKeysRefs,omitempty"`
+}
@@ -37,6 +37,26 @@ type ProxyTracing struct {
 	Provider TracingProvider `json:"provider"`
 }
 
+// RouteTracing defines the tracing configuration of routes, overriding the
+// tracing configuration of the proxy.
+// +kubebuilder:validation:XValidation:message="samplingRate can't be set when tracing is disabled",rule="!(has(self.disable) && self.disable && has(self.samplingRate))"
+type RouteTracing struct {
+	// Disable disables the tracing of the requests matching the routes,
+	// for example to not trace the health check requests.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+	// SamplingRate controls the rate at which the requests matching the routes
+	// will be selected for tracing if no prior sampling decision has been made.
+	// Valid values [0-100]. 100 indicates 100% sampling.
+	// Defaults to the sampling rate of the proxy.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingRate *uint32 `json:"samplingRate,omitempty"`
+}
+
 type TracingProviderType string
 
 const (
@@ -72,6 +92,14 @@ type TracingProvider struct {
 	// Zipkin defines the Zipkin tracing provider configuration
 	// +optional
 	Zipkin *ZipkinTracingProvider `json:"zipkin,omitempty"`
+	// ServiceName defines the service name reported in the spans.
+	// Defaults to "name.namespace" of the Gateway, or to the name of the
+	// GatewayClass when the Gateways are merged.
+	// Only supported by the OpenTelemetry and Datadog tracing providers.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ServiceName *string `json:"serviceName,omitempty"`
 }
 
 type CustomTagType string
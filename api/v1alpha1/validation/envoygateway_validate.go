@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/url"
 
+	"k8s.io/utils/ptr"
+
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
@@ -51,7 +53,7 @@ func ValidateEnvoyGateway(eg *egv1a1.EnvoyGateway) error {
 		return err
 	}
 
-	if err := validateEnvoyGatewayExtensionManager(eg.ExtensionManager); err != nil {
+	if err := validateEnvoyGatewayExtensionManagers(eg.GetExtensionManagers()); err != nil {
 		return err
 	}
 
@@ -63,7 +65,15 @@ func ValidateEnvoyGateway(eg *egv1a1.EnvoyGateway) error {
 }
 
 func validateEnvoyGatewayKubernetesProvider(provider *egv1a1.EnvoyGatewayKubernetesProvider) error {
-	if provider == nil || provider.Watch == nil {
+	if provider == nil {
+		return nil
+	}
+
+	if err := validateEnvoyGatewayRateLimitDeployment(provider.RateLimitDeployment); err != nil {
+		return err
+	}
+
+	if provider.Watch == nil {
 		return nil
 	}
 
@@ -83,6 +93,18 @@ func validateEnvoyGatewayKubernetesProvider(provider *egv1a1.EnvoyGatewayKuberne
 	return nil
 }
 
+// validateEnvoyGatewayRateLimitDeployment rejects more than one replica of the rate limit deployment
+// on the host network, because the pods scheduled on the same node would bind the same ports.
+func validateEnvoyGatewayRateLimitDeployment(deployment *egv1a1.KubernetesDeploymentSpec) error {
+	if deployment == nil || deployment.Pod == nil || !ptr.Deref(deployment.Pod.HostNetwork, false) {
+		return nil
+	}
+	if ptr.Deref(deployment.Replicas, egv1a1.DefaultDeploymentReplicas) > 1 {
+		return fmt.Errorf("hostNetwork cannot be used with more than one replica of the rate limit deployment")
+	}
+	return nil
+}
+
 func validateEnvoyGatewayCustomProvider(provider *egv1a1.EnvoyGatewayCustomProvider) error {
 	if provider == nil {
 		return fmt.Errorf("empty custom provider settings")
@@ -173,16 +195,41 @@ func validateEnvoyGatewayRateLimit(rateLimit *egv1a1.RateLimit) error {
 	return nil
 }
 
+func validateEnvoyGatewayExtensionManagers(extensionManagers []*egv1a1.ExtensionManager) error {
+	names := make(map[string]bool, len(extensionManagers))
+	for _, extensionManager := range extensionManagers {
+		if err := validateEnvoyGatewayExtensionManager(extensionManager); err != nil {
+			return err
+		}
+
+		// Names are only required to tell the extensions apart when more than one is registered
+		if len(extensionManagers) > 1 {
+			if extensionManager.Name == "" {
+				return fmt.Errorf("extension manager name must be specified when multiple extension managers are registered")
+			}
+			if names[extensionManager.Name] {
+				return fmt.Errorf("duplicate extension manager name %s", extensionManager.Name)
+			}
+			names[extensionManager.Name] = true
+		}
+	}
+	return nil
+}
+
 func validateEnvoyGatewayExtensionManager(extensionManager *egv1a1.ExtensionManager) error {
 	if extensionManager == nil {
 		return nil
 	}
 
-	if extensionManager.Hooks == nil || extensionManager.Hooks.XDSTranslator == nil {
+	if extensionManager.Hooks == nil || (extensionManager.Hooks.XDSTranslator == nil && extensionManager.Hooks.Infrastructure == nil) {
 		return fmt.Errorf("registered extension has no hooks specified")
 	}
 
-	if len(extensionManager.Hooks.XDSTranslator.Pre) == 0 && len(extensionManager.Hooks.XDSTranslator.Post) == 0 {
+	hasXDSTranslatorHooks := extensionManager.Hooks.XDSTranslator != nil &&
+		(len(extensionManager.Hooks.XDSTranslator.Pre) > 0 || len(extensionManager.Hooks.XDSTranslator.Post) > 0)
+	hasInfrastructureHooks := extensionManager.Hooks.Infrastructure != nil &&
+		len(extensionManager.Hooks.Infrastructure.Post) > 0
+	if !hasXDSTranslatorHooks && !hasInfrastructureHooks {
 		return fmt.Errorf("registered extension has no hooks specified")
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -336,6 +337,154 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			name: "happy multiple extension managers",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &egv1a1.ExtensionManager{
+						Name: "security",
+						Hooks: &egv1a1.ExtensionHooks{
+							XDSTranslator: &egv1a1.XDSTranslatorHooks{
+								Post: []egv1a1.XDSTranslatorHook{
+									egv1a1.XDSHTTPListener,
+								},
+							},
+						},
+						Service: &egv1a1.ExtensionService{
+							Host: "security.extension",
+							Port: 80,
+						},
+					},
+					ExtensionManagers: []egv1a1.ExtensionManager{
+						{
+							Name: "routing",
+							Resources: []egv1a1.GroupVersionKind{
+								{
+									Group:   "foo.example.io",
+									Version: "v1alpha1",
+									Kind:    "Foo",
+								},
+							},
+							Hooks: &egv1a1.ExtensionHooks{
+								XDSTranslator: &egv1a1.XDSTranslatorHooks{
+									Post: []egv1a1.XDSTranslatorHook{
+										egv1a1.XDSRoute,
+									},
+								},
+							},
+							Service: &egv1a1.ExtensionService{
+								Host: "routing.extension",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "multiple extension managers without names",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					ExtensionManagers: []egv1a1.ExtensionManager{
+						{
+							Hooks: &egv1a1.ExtensionHooks{
+								XDSTranslator: &egv1a1.XDSTranslatorHooks{
+									Post: []egv1a1.XDSTranslatorHook{
+										egv1a1.XDSRoute,
+									},
+								},
+							},
+							Service: &egv1a1.ExtensionService{
+								Host: "foo.extension",
+								Port: 80,
+							},
+						},
+						{
+							Hooks: &egv1a1.ExtensionHooks{
+								XDSTranslator: &egv1a1.XDSTranslatorHooks{
+									Post: []egv1a1.XDSTranslatorHook{
+										egv1a1.XDSRoute,
+									},
+								},
+							},
+							Service: &egv1a1.ExtensionService{
+								Host: "bar.extension",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "multiple extension managers with duplicate names",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &egv1a1.ExtensionManager{
+						Name: "foo",
+						Hooks: &egv1a1.ExtensionHooks{
+							XDSTranslator: &egv1a1.XDSTranslatorHooks{
+								Post: []egv1a1.XDSTranslatorHook{
+									egv1a1.XDSRoute,
+								},
+							},
+						},
+						Service: &egv1a1.ExtensionService{
+							Host: "foo.extension",
+							Port: 80,
+						},
+					},
+					ExtensionManagers: []egv1a1.ExtensionManager{
+						{
+							Name: "foo",
+							Hooks: &egv1a1.ExtensionHooks{
+								XDSTranslator: &egv1a1.XDSTranslatorHooks{
+									Post: []egv1a1.XDSTranslatorHook{
+										egv1a1.XDSRoute,
+									},
+								},
+							},
+							Service: &egv1a1.ExtensionService{
+								Host: "bar.extension",
+								Port: 80,
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy extension settings infrastructure hooks only",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &egv1a1.ExtensionManager{
+						Hooks: &egv1a1.ExtensionHooks{
+							Infrastructure: &egv1a1.InfrastructureHooks{
+								Post: []egv1a1.InfrastructureHook{
+									egv1a1.InfrastructureResources,
+								},
+							},
+						},
+						Service: &egv1a1.ExtensionService{
+							Host: "foo.extension",
+							Port: 80,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
 		{
 			name: "happy extension settings tls",
 			eg: &egv1a1.EnvoyGateway{
@@ -674,6 +823,45 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "happy rate limit deployment with one replica on the host network",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway: egv1a1.DefaultGateway(),
+					Provider: &egv1a1.EnvoyGatewayProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+							RateLimitDeployment: &egv1a1.KubernetesDeploymentSpec{
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "fail rate limit deployment with more than one replica on the host network",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway: egv1a1.DefaultGateway(),
+					Provider: &egv1a1.EnvoyGatewayProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+							RateLimitDeployment: &egv1a1.KubernetesDeploymentSpec{
+								Replicas: ptr.To[int32](2),
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
 		{
 			name: "happy namespaceSelector must be set when watch mode is NamespaceSelector",
 			eg: &egv1a1.EnvoyGateway{
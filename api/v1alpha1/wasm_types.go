@@ -149,6 +149,23 @@ type ImageWasmCodeSource struct {
 	// +kubebuilder:validation:XValidation:message="only support Secret kind.",rule="self.kind == 'Secret'"
 	// +optional
 	PullSecretRef *gwapiv1.SecretObjectReference `json:"pullSecretRef,omitempty"`
+
+	// SignatureVerification configures the verification of the cosign signature of the OCI image.
+	//
+	// If specified, Envoy Gateway only uses the image if it has a signature that is valid for the public key.
+	//
+	// +optional
+	SignatureVerification *ImageSignatureVerification `json:"signatureVerification,omitempty"`
+}
+
+// ImageSignatureVerification defines how the signature of an OCI image is verified.
+// Only the signatures created with a key by `cosign sign --key` are supported.
+type ImageSignatureVerification struct {
+	// PublicKeyRef is a reference to the secret containing the PEM encoded public key, in the `cosign.pub` key,
+	// used to verify the signature of the image.
+	// Only support Kubernetes Secret resource from the same namespace.
+	// +kubebuilder:validation:XValidation:message="only support Secret kind.",rule="self.kind == 'Secret'"
+	PublicKeyRef gwapiv1.SecretObjectReference `json:"publicKeyRef"`
 }
 
 // ImagePullPolicy defines the policy to use when pulling an OIC image.
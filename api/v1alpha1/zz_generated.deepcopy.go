@@ -445,6 +445,11 @@ func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(ProxyProtocol)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -564,6 +569,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 	*out = *in
 	in.PolicyTargetReferences.DeepCopyInto(&out.PolicyTargetReferences)
 	in.ClusterSettings.DeepCopyInto(&out.ClusterSettings)
+	if in.MergeType != nil {
+		in, out := &in.MergeType, &out.MergeType
+		*out = new(MergeType)
+		**out = **in
+	}
 	if in.RateLimit != nil {
 		in, out := &in.RateLimit, &out.RateLimit
 		*out = new(RateLimitSpec)
@@ -579,6 +589,22 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HTTPUpgrade != nil {
+		in, out := &in.HTTPUpgrade, &out.HTTPUpgrade
+		*out = make([]*ProtocolUpgradeConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProtocolUpgradeConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TCPTunneling != nil {
+		in, out := &in.TCPTunneling, &out.TCPTunneling
+		*out = new(TCPTunneling)
+		**out = **in
+	}
 	if in.Compression != nil {
 		in, out := &in.Compression, &out.Compression
 		*out = make([]*Compression, len(*in))
@@ -590,6 +616,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 			}
 		}
 	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(ResponseCache)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResponseOverride != nil {
 		in, out := &in.ResponseOverride, &out.ResponseOverride
 		*out = make([]*ResponseOverride, len(*in))
@@ -601,6 +632,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 			}
 		}
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(RouteTracing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendTrafficPolicySpec.
@@ -938,6 +974,11 @@ func (in *ClientTrafficPolicySpec) DeepCopyInto(out *ClientTrafficPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(ProxyProtocolSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClientIPDetection != nil {
 		in, out := &in.ClientIPDetection, &out.ClientIPDetection
 		*out = new(ClientIPDetectionSettings)
@@ -988,6 +1029,11 @@ func (in *ClientTrafficPolicySpec) DeepCopyInto(out *ClientTrafficPolicySpec) {
 		*out = new(HealthCheckSettings)
 		**out = **in
 	}
+	if in.IPTagging != nil {
+		in, out := &in.IPTagging, &out.IPTagging
+		*out = new(IPTaggingSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTrafficPolicySpec.
@@ -1010,6 +1056,11 @@ func (in *ClientValidationContext) DeepCopyInto(out *ClientValidationContext) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Crl != nil {
+		in, out := &in.Crl, &out.Crl
+		*out = new(CrlContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientValidationContext.
@@ -1100,6 +1151,21 @@ func (in *Compression) DeepCopyInto(out *Compression) {
 		*out = new(GzipCompressor)
 		**out = **in
 	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(ZstdCompressor)
+		**out = **in
+	}
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
@@ -1145,6 +1211,16 @@ func (in *ConsistentHash) DeepCopyInto(out *ConsistentHash) {
 		*out = new(Cookie)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueryParameter != nil {
+		in, out := &in.QueryParameter, &out.QueryParameter
+		*out = new(QueryParameter)
+		**out = **in
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(ConsistentHashAlgorithm)
+		**out = **in
+	}
 	if in.TableSize != nil {
 		in, out := &in.TableSize, &out.TableSize
 		*out = new(uint64)
@@ -1177,6 +1253,11 @@ func (in *Cookie) DeepCopyInto(out *Cookie) {
 			(*out)[key] = val
 		}
 	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cookie.
@@ -1189,6 +1270,33 @@ func (in *Cookie) DeepCopy() *Cookie {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrlContext) DeepCopyInto(out *CrlContext) {
+	*out = *in
+	if in.Refs != nil {
+		in, out := &in.Refs, &out.Refs
+		*out = make([]v1.SecretObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnlyVerifyLeafCertificate != nil {
+		in, out := &in.OnlyVerifyLeafCertificate, &out.OnlyVerifyLeafCertificate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrlContext.
+func (in *CrlContext) DeepCopy() *CrlContext {
+	if in == nil {
+		return nil
+	}
+	out := new(CrlContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomHeaderExtensionSettings) DeepCopyInto(out *CustomHeaderExtensionSettings) {
 	*out = *in
@@ -1227,6 +1335,11 @@ func (in *CustomResponse) DeepCopyInto(out *CustomResponse) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Templated != nil {
+		in, out := &in.Templated, &out.Templated
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponse.
@@ -1279,6 +1392,11 @@ func (in *CustomResponseMatch) DeepCopyInto(out *CustomResponseMatch) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]ResponseFailureType, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponseMatch.
@@ -1450,6 +1568,11 @@ func (in *EnvoyExtensionPolicySpec) DeepCopyInto(out *EnvoyExtensionPolicySpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionPolicySpec.
@@ -1835,6 +1958,13 @@ func (in *EnvoyGatewaySpec) DeepCopyInto(out *EnvoyGatewaySpec) {
 		*out = new(ExtensionManager)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtensionManagers != nil {
+		in, out := &in.ExtensionManagers, &out.ExtensionManagers
+		*out = make([]ExtensionManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExtensionAPIs != nil {
 		in, out := &in.ExtensionAPIs, &out.ExtensionAPIs
 		*out = new(ExtensionAPISettings)
@@ -2241,6 +2371,11 @@ func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.MaxMessageTimeout != nil {
+		in, out := &in.MaxMessageTimeout, &out.MaxMessageTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.FailOpen != nil {
 		in, out := &in.FailOpen, &out.FailOpen
 		*out = new(bool)
@@ -2341,6 +2476,11 @@ func (in *ExtensionHooks) DeepCopyInto(out *ExtensionHooks) {
 		*out = new(XDSTranslatorHooks)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(InfrastructureHooks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionHooks.
@@ -2631,6 +2771,27 @@ func (in *GRPCExtAuthService) DeepCopy() *GRPCExtAuthService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	in.ProtoDescriptor.DeepCopyInto(&out.ProtoDescriptor)
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gateway) DeepCopyInto(out *Gateway) {
 	*out = *in
@@ -2766,6 +2927,21 @@ func (in *HTTP2Settings) DeepCopyInto(out *HTTP2Settings) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaxOutboundFrames != nil {
+		in, out := &in.MaxOutboundFrames, &out.MaxOutboundFrames
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxOutboundControlFrames != nil {
+		in, out := &in.MaxOutboundControlFrames, &out.MaxOutboundControlFrames
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConsecutiveInboundFramesWithEmptyPayload != nil {
+		in, out := &in.MaxConsecutiveInboundFramesWithEmptyPayload, &out.MaxConsecutiveInboundFramesWithEmptyPayload
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.OnInvalidMessage != nil {
 		in, out := &in.OnInvalidMessage, &out.OnInvalidMessage
 		*out = new(InvalidMessageAction)
@@ -2828,6 +3004,22 @@ func (in *HTTPActiveHealthChecker) DeepCopy() *HTTPActiveHealthChecker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBodyMatch) DeepCopyInto(out *HTTPBodyMatch) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBodyMatch.
+func (in *HTTPBodyMatch) DeepCopy() *HTTPBodyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBodyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPClientTimeout) DeepCopyInto(out *HTTPClientTimeout) {
 	*out = *in
@@ -2841,6 +3033,11 @@ func (in *HTTPClientTimeout) DeepCopyInto(out *HTTPClientTimeout) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StreamIdleTimeout != nil {
+		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPClientTimeout.
@@ -2853,6 +3050,27 @@ func (in *HTTPClientTimeout) DeepCopy() *HTTPClientTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCredentialInjectionFilter) DeepCopyInto(out *HTTPCredentialInjectionFilter) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCredentialInjectionFilter.
+func (in *HTTPCredentialInjectionFilter) DeepCopy() *HTTPCredentialInjectionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCredentialInjectionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPDirectResponseFilter) DeepCopyInto(out *HTTPDirectResponseFilter) {
 	*out = *in
@@ -3020,6 +3238,16 @@ func (in *HTTPRouteFilterSpec) DeepCopyInto(out *HTTPRouteFilterSpec) {
 		*out = new(HTTPDirectResponseFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyMatch != nil {
+		in, out := &in.BodyMatch, &out.BodyMatch
+		*out = new(HTTPBodyMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialInjection != nil {
+		in, out := &in.CredentialInjection, &out.CredentialInjection
+		*out = new(HTTPCredentialInjectionFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilterSpec.
@@ -3050,6 +3278,11 @@ func (in *HTTPTimeout) DeepCopyInto(out *HTTPTimeout) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StreamIdleTimeout != nil {
+		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTimeout.
@@ -3257,6 +3490,69 @@ func (in *IPEndpoint) DeepCopy() *IPEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTag) DeepCopyInto(out *IPTag) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
+func (in *IPTag) DeepCopy() *IPTag {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTaggingSettings) DeepCopyInto(out *IPTaggingSettings) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]IPTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTaggingSettings.
+func (in *IPTaggingSettings) DeepCopy() *IPTaggingSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTaggingSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignatureVerification) DeepCopyInto(out *ImageSignatureVerification) {
+	*out = *in
+	in.PublicKeyRef.DeepCopyInto(&out.PublicKeyRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignatureVerification.
+func (in *ImageSignatureVerification) DeepCopy() *ImageSignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageWasmCodeSource) DeepCopyInto(out *ImageWasmCodeSource) {
 	*out = *in
@@ -3270,6 +3566,11 @@ func (in *ImageWasmCodeSource) DeepCopyInto(out *ImageWasmCodeSource) {
 		*out = new(v1.SecretObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(ImageSignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageWasmCodeSource.
@@ -3282,6 +3583,26 @@ func (in *ImageWasmCodeSource) DeepCopy() *ImageWasmCodeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureHooks) DeepCopyInto(out *InfrastructureHooks) {
+	*out = *in
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = make([]InfrastructureHook, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureHooks.
+func (in *InfrastructureHooks) DeepCopy() *InfrastructureHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
 	*out = *in
@@ -3793,6 +4114,11 @@ func (in *KubernetesPodSpec) DeepCopyInto(out *KubernetesPodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HostNetwork != nil {
+		in, out := &in.HostNetwork, &out.HostNetwork
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPodSpec.
@@ -3934,6 +4260,26 @@ func (in *LeaderElection) DeepCopy() *LeaderElection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeastRequest) DeepCopyInto(out *LeastRequest) {
+	*out = *in
+	if in.ChoiceCount != nil {
+		in, out := &in.ChoiceCount, &out.ChoiceCount
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeastRequest.
+func (in *LeastRequest) DeepCopy() *LeastRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LeastRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiteralCustomTag) DeepCopyInto(out *LiteralCustomTag) {
 	*out = *in
@@ -3957,6 +4303,11 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(ConsistentHash)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LeastRequest != nil {
+		in, out := &in.LeastRequest, &out.LeastRequest
+		*out = new(LeastRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SlowStart != nil {
 		in, out := &in.SlowStart, &out.SlowStart
 		*out = new(SlowStart)
@@ -4351,6 +4702,51 @@ func (in *ProcessingModeOptions) DeepCopy() *ProcessingModeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtoDescriptor) DeepCopyInto(out *ProtoDescriptor) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtoDescriptor.
+func (in *ProtoDescriptor) DeepCopy() *ProtoDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtoDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolUpgradeConfig) DeepCopyInto(out *ProtocolUpgradeConfig) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolUpgradeConfig.
+func (in *ProtocolUpgradeConfig) DeepCopy() *ProtocolUpgradeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolUpgradeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLog) DeepCopyInto(out *ProxyAccessLog) {
 	*out = *in
@@ -4529,6 +4925,11 @@ func (in *ProxyMetricSink) DeepCopyInto(out *ProxyMetricSink) {
 		*out = new(ProxyOpenTelemetrySink)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatsD != nil {
+		in, out := &in.StatsD, &out.StatsD
+		*out = new(ProxyStatsDSink)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyMetricSink.
@@ -4568,6 +4969,11 @@ func (in *ProxyMetrics) DeepCopyInto(out *ProxyMetrics) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableRouteStats != nil {
+		in, out := &in.EnableRouteStats, &out.EnableRouteStats
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EnablePerEndpointStats != nil {
 		in, out := &in.EnablePerEndpointStats, &out.EnablePerEndpointStats
 		*out = new(bool)
@@ -4646,6 +5052,47 @@ func (in *ProxyProtocol) DeepCopy() *ProxyProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyProtocolSettings) DeepCopyInto(out *ProxyProtocolSettings) {
+	*out = *in
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyProtocolSettings.
+func (in *ProxyProtocolSettings) DeepCopy() *ProxyProtocolSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyProtocolSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyStatsDSink) DeepCopyInto(out *ProxyStatsDSink) {
+	*out = *in
+	in.BackendCluster.DeepCopyInto(&out.BackendCluster)
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatsDSink.
+func (in *ProxyStatsDSink) DeepCopy() *ProxyStatsDSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyStatsDSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyTelemetry) DeepCopyInto(out *ProxyTelemetry) {
 	*out = *in
@@ -4709,6 +5156,21 @@ func (in *ProxyTracing) DeepCopy() *ProxyTracing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameter) DeepCopyInto(out *QueryParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameter.
+func (in *QueryParameter) DeepCopy() *QueryParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
@@ -4913,6 +5375,11 @@ func (in *RateLimitSelectCondition) DeepCopyInto(out *RateLimitSelectCondition)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(v1.HTTPPathMatch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SourceCIDR != nil {
 		in, out := &in.SourceCIDR, &out.SourceCIDR
 		*out = new(SourceMatch)
@@ -5111,6 +5578,61 @@ func (in *RequestHeaderCustomTag) DeepCopy() *RequestHeaderCustomTag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCache) DeepCopyInto(out *ResponseCache) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ResponseCacheType)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ResponseCacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCache.
+func (in *ResponseCache) DeepCopy() *ResponseCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCacheKey) DeepCopyInto(out *ResponseCacheKey) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCacheKey.
+func (in *ResponseCacheKey) DeepCopy() *ResponseCacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseOverride) DeepCopyInto(out *ResponseOverride) {
 	*out = *in
@@ -5183,6 +5705,31 @@ func (in *RetryOn) DeepCopy() *RetryOn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTracing) DeepCopyInto(out *RouteTracing) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTracing.
+func (in *RouteTracing) DeepCopy() *RouteTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicy) DeepCopyInto(out *SecurityPolicy) {
 	*out = *in
@@ -5319,7 +5866,7 @@ func (in *SessionResumption) DeepCopyInto(out *SessionResumption) {
 	if in.Stateless != nil {
 		in, out := &in.Stateless, &out.Stateless
 		*out = new(StatelessTLSSessionResumption)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Stateful != nil {
 		in, out := &in.Stateful, &out.Stateful
@@ -5391,6 +5938,11 @@ func (in *SlowStart) DeepCopyInto(out *SlowStart) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(float32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlowStart.
@@ -5441,6 +5993,13 @@ func (in *StatefulTLSSessionResumption) DeepCopy() *StatefulTLSSessionResumption
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatelessTLSSessionResumption) DeepCopyInto(out *StatelessTLSSessionResumption) {
 	*out = *in
+	if in.SessionTicketKeysRefs != nil {
+		in, out := &in.SessionTicketKeysRefs, &out.SessionTicketKeysRefs
+		*out = make([]v1.SecretObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatelessTLSSessionResumption.
@@ -5613,6 +6172,21 @@ func (in *TCPTimeout) DeepCopy() *TCPTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPTunneling) DeepCopyInto(out *TCPTunneling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPTunneling.
+func (in *TCPTunneling) DeepCopy() *TCPTunneling {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPTunneling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSettings) DeepCopyInto(out *TLSSettings) {
 	*out = *in
@@ -5731,6 +6305,11 @@ func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
 		*out = new(ZipkinTracingProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
@@ -5948,3 +6527,18 @@ func (in *ZipkinTracingProvider) DeepCopy() *ZipkinTracingProvider {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZstdCompressor) DeepCopyInto(out *ZstdCompressor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZstdCompressor.
+func (in *ZstdCompressor) DeepCopy() *ZstdCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(ZstdCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
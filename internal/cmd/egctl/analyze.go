@@ -0,0 +1,391 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+func newAnalyzeCommand() *cobra.Command {
+	var (
+		inFile, namespace   string
+		addMissingResources bool
+	)
+
+	analyzeCommand := &cobra.Command{
+		Use:   "analyze",
+		Short: "Explain why a route is accepted or rejected by the Gateways it references",
+		Example: `  # Explain why the parentRefs of a HTTPRoute are accepted or rejected.
+  egctl x analyze httproute <name> -n <namespace> -f <input file>
+
+  # Explain why the parentRefs of a HTTPRoute are accepted or rejected, with dummy resources added.
+  egctl x analyze httproute <name> --add-missing-resources -f <input file>
+	`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("invalid args: must specify a resource type and a resource name")
+			}
+
+			switch strings.ToLower(args[0]) {
+			case "httproute":
+				return runAnalyzeHTTPRoute(cmd.OutOrStdout(), inFile, args[1], namespace, addMissingResources)
+			default:
+				return fmt.Errorf("unsupported resource type: %s, only httproute is supported", args[0])
+			}
+		},
+	}
+
+	analyzeCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file.")
+	if err := analyzeCommand.MarkPersistentFlagRequired("file"); err != nil {
+		return nil
+	}
+	analyzeCommand.PersistentFlags().StringVarP(&namespace, "namespace", "n", "default", "Namespace of the resource.")
+	analyzeCommand.PersistentFlags().BoolVarP(&addMissingResources, "add-missing-resources", "", false, "Provides dummy resources if missed")
+
+	return analyzeCommand
+}
+
+func runAnalyzeHTTPRoute(w io.Writer, inFile, name, namespace string, addMissingResources bool) error {
+	inBytes, err := getInputBytes(inFile)
+	if err != nil {
+		return fmt.Errorf("unable to read input file: %w", err)
+	}
+
+	resources, err := resource.LoadResourcesFromYAMLBytes(inBytes, addMissingResources)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+
+	// Run the translation to compute the status of the Gateways and Routes.
+	translated, err := translateGatewayAPIToGatewayAPI(resources)
+	if err != nil {
+		return err
+	}
+
+	var route *gwapiv1.HTTPRoute
+	for _, r := range resources.HTTPRoutes {
+		if r.Namespace == namespace && r.Name == name {
+			route = r
+			break
+		}
+	}
+	if route == nil {
+		return fmt.Errorf("HTTPRoute %s/%s not found", namespace, name)
+	}
+
+	// The translator only returns the routes attached to one of its Gateways, with their computed status.
+	for _, r := range translated.HTTPRoutes {
+		if r.Namespace == namespace && r.Name == name {
+			route = r
+			break
+		}
+	}
+
+	a := &routeAnalyzer{
+		w:          w,
+		resources:  resources,
+		translated: &translated,
+		kind:       resource.KindHTTPRoute,
+		namespace:  route.Namespace,
+		hostnames:  route.Spec.Hostnames,
+		status:     &route.Status.RouteStatus,
+	}
+
+	fmt.Fprintf(w, "%s %s/%s\n", resource.KindHTTPRoute, route.Namespace, route.Name)
+	for _, parentRef := range route.Spec.ParentRefs {
+		a.analyzeParentRef(parentRef)
+	}
+
+	var backendRefs []gwapiv1.BackendObjectReference
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+		}
+	}
+	a.analyzeBackendRefs(backendRefs)
+
+	return nil
+}
+
+// routeAnalyzer walks the references of a route the same way the Gateway API translator
+// does, and explains each step.
+type routeAnalyzer struct {
+	w io.Writer
+	// resources are the input resources, and translated holds the
+	// Gateways and Routes with the status computed by the translator.
+	resources  *resource.Resources
+	translated *resource.Resources
+	kind       string
+	namespace  string
+	hostnames  []gwapiv1.Hostname
+	status     *gwapiv1.RouteStatus
+}
+
+func (a *routeAnalyzer) analyzeParentRef(parentRef gwapiv1.ParentReference) {
+	gwNamespace := gatewayapi.NamespaceDerefOr(parentRef.Namespace, a.namespace)
+	fmt.Fprintf(a.w, "\nParentRef %s/%s", gwNamespace, parentRef.Name)
+	if parentRef.SectionName != nil {
+		fmt.Fprintf(a.w, " sectionName=%s", *parentRef.SectionName)
+	}
+	if parentRef.Port != nil {
+		fmt.Fprintf(a.w, " port=%d", *parentRef.Port)
+	}
+	fmt.Fprintln(a.w, ":")
+
+	if gatewayapi.GroupDerefOr(parentRef.Group, gwapiv1.GroupName) != gwapiv1.GroupName ||
+		gatewayapi.KindDerefOr(parentRef.Kind, resource.KindGateway) != resource.KindGateway {
+		fmt.Fprintln(a.w, "  not a Gateway, ignored by Envoy Gateway")
+		return
+	}
+
+	var gateway *gwapiv1.Gateway
+	for _, gw := range a.translated.Gateways {
+		if gatewayapi.IsRefToGateway(gwapiv1.Namespace(a.namespace), parentRef, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}) {
+			gateway = gw
+			break
+		}
+	}
+	if gateway == nil {
+		// The translator only returns the Gateways of the GatewayClass managed by Envoy Gateway.
+		for _, gw := range a.resources.Gateways {
+			if gatewayapi.IsRefToGateway(gwapiv1.Namespace(a.namespace), parentRef, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}) {
+				fmt.Fprintf(a.w, "  Gateway uses GatewayClass %s, which is not managed by Envoy Gateway\n", gw.Spec.GatewayClassName)
+				return
+			}
+		}
+		fmt.Fprintf(a.w, "  Gateway %s/%s not found\n", gwNamespace, parentRef.Name)
+		return
+	}
+
+	for _, listener := range gateway.Spec.Listeners {
+		fmt.Fprintf(a.w, "  Listener %s: %s\n", listener.Name, a.analyzeListener(gateway, listener, parentRef))
+	}
+
+	for _, parent := range a.status.Parents {
+		if !reflect.DeepEqual(parent.ParentRef, parentRef) {
+			continue
+		}
+		for _, cond := range parent.Conditions {
+			fmt.Fprintf(a.w, "  %s: %s (%s) %s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+		}
+	}
+}
+
+// analyzeListener returns a human-readable explanation of whether the route can attach to the listener.
+func (a *routeAnalyzer) analyzeListener(gateway *gwapiv1.Gateway, listener gwapiv1.Listener, parentRef gwapiv1.ParentReference) string {
+	if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+		return "not selected by sectionName"
+	}
+	if parentRef.Port != nil && *parentRef.Port != listener.Port {
+		return fmt.Sprintf("not selected by port, listener port is %d", listener.Port)
+	}
+
+	var listenerStatus *gwapiv1.ListenerStatus
+	for i := range gateway.Status.Listeners {
+		if gateway.Status.Listeners[i].Name == listener.Name {
+			listenerStatus = &gateway.Status.Listeners[i]
+			break
+		}
+	}
+	if listenerStatus == nil {
+		return "listener has no status, the Gateway was not translated"
+	}
+
+	if !listenerAllowsKind(listenerStatus, a.kind) {
+		var kinds []string
+		for _, kind := range listenerStatus.SupportedKinds {
+			kinds = append(kinds, string(kind.Kind))
+		}
+		return fmt.Sprintf("%s is not allowed, supported kinds are [%s]", a.kind, strings.Join(kinds, ", "))
+	}
+
+	if allowed, reason := a.listenerAllowsNamespace(gateway, listener); !allowed {
+		return reason
+	}
+
+	if !listenerIsProgrammed(listenerStatus) {
+		return "listener is not programmed"
+	}
+
+	hostnames := intersectHostnames(listener.Hostname, a.hostnames)
+	if len(hostnames) == 0 {
+		return fmt.Sprintf("no route hostname intersects with listener hostname %s", *listener.Hostname)
+	}
+
+	return fmt.Sprintf("%s is allowed, hostnames [%s]", a.kind, strings.Join(hostnames, ", "))
+}
+
+func listenerAllowsKind(listenerStatus *gwapiv1.ListenerStatus, kind string) bool {
+	for _, supported := range listenerStatus.SupportedKinds {
+		if gatewayapi.GroupDerefOr(supported.Group, gwapiv1.GroupName) == gwapiv1.GroupName &&
+			string(supported.Kind) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func listenerIsProgrammed(listenerStatus *gwapiv1.ListenerStatus) bool {
+	for _, cond := range listenerStatus.Conditions {
+		if cond.Type == string(gwapiv1.ListenerConditionProgrammed) && cond.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *routeAnalyzer) listenerAllowsNamespace(gateway *gwapiv1.Gateway, listener gwapiv1.Listener) (bool, string) {
+	from := gwapiv1.NamespacesFromSame
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil && listener.AllowedRoutes.Namespaces.From != nil {
+		from = *listener.AllowedRoutes.Namespaces.From
+	}
+
+	switch from {
+	case gwapiv1.NamespacesFromAll:
+		return true, ""
+	case gwapiv1.NamespacesFromSelector:
+		ns := a.resources.GetNamespace(a.namespace)
+		if ns == nil {
+			return false, fmt.Sprintf("namespace %s not found", a.namespace)
+		}
+		if listener.AllowedRoutes.Namespaces.Selector == nil {
+			return false, "allowedRoutes selects namespaces by selector, but no selector is set"
+		}
+		selector, err := metav1.LabelSelectorAsSelector(listener.AllowedRoutes.Namespaces.Selector)
+		if err != nil {
+			return false, fmt.Sprintf("invalid allowedRoutes namespace selector: %v", err)
+		}
+		if !selector.Matches(labels.Set(ns.Labels)) {
+			return false, fmt.Sprintf("namespace %s does not match the allowedRoutes namespace selector %s", a.namespace, selector)
+		}
+		return true, ""
+	default:
+		if gateway.Namespace != a.namespace {
+			return false, fmt.Sprintf("allowedRoutes only allows routes from the Gateway namespace %s", gateway.Namespace)
+		}
+		return true, ""
+	}
+}
+
+// intersectHostnames returns the hostnames the route would be served on by the listener.
+func intersectHostnames(listenerHostname *gwapiv1.Hostname, routeHostnames []gwapiv1.Hostname) []string {
+	if listenerHostname == nil || len(*listenerHostname) == 0 {
+		if len(routeHostnames) == 0 {
+			return []string{"*"}
+		}
+		var hostnames []string
+		for _, h := range routeHostnames {
+			hostnames = append(hostnames, string(h))
+		}
+		return hostnames
+	}
+
+	lh := string(*listenerHostname)
+	if len(routeHostnames) == 0 {
+		return []string{lh}
+	}
+
+	var hostnames []string
+	for _, h := range routeHostnames {
+		rh := string(h)
+		switch {
+		case rh == lh:
+			hostnames = append(hostnames, rh)
+		case strings.HasPrefix(lh, "*") && gatewayapi.HostnameMatchesWildcardHostname(rh, lh):
+			hostnames = append(hostnames, rh)
+		case strings.HasPrefix(rh, "*") && gatewayapi.HostnameMatchesWildcardHostname(lh, rh):
+			hostnames = append(hostnames, lh)
+		}
+	}
+	return hostnames
+}
+
+func (a *routeAnalyzer) analyzeBackendRefs(backendRefs []gwapiv1.BackendObjectReference) {
+	if len(backendRefs) == 0 {
+		return
+	}
+
+	fmt.Fprintln(a.w, "\nBackendRefs:")
+	for _, backendRef := range backendRefs {
+		kind := gatewayapi.KindDerefOr(backendRef.Kind, resource.KindService)
+		ns := gatewayapi.NamespaceDerefOr(backendRef.Namespace, a.namespace)
+		ref := fmt.Sprintf("%s %s/%s", kind, ns, backendRef.Name)
+		if backendRef.Port != nil {
+			ref = fmt.Sprintf("%s:%d", ref, *backendRef.Port)
+		}
+
+		var found bool
+		switch kind {
+		case resource.KindService:
+			found = a.resources.GetService(ns, string(backendRef.Name)) != nil
+		case resource.KindServiceImport:
+			found = a.resources.GetServiceImport(ns, string(backendRef.Name)) != nil
+		case resource.KindBackend:
+			found = a.resources.GetBackend(ns, string(backendRef.Name)) != nil
+		default:
+			fmt.Fprintf(a.w, "  %s: unsupported kind\n", ref)
+			continue
+		}
+		if !found {
+			fmt.Fprintf(a.w, "  %s: not found\n", ref)
+			continue
+		}
+
+		if ns != a.namespace {
+			group := gatewayapi.GroupDerefOr(backendRef.Group, "")
+			if grant := a.findReferenceGrant(group, kind, ns, string(backendRef.Name)); grant != nil {
+				fmt.Fprintf(a.w, "  %s: found, cross-namespace reference allowed by ReferenceGrant %s/%s\n", ref, grant.Namespace, grant.Name)
+			} else {
+				fmt.Fprintf(a.w, "  %s: found, cross-namespace reference not allowed, no ReferenceGrant in namespace %s allows %s references from namespace %s\n",
+					ref, ns, a.kind, a.namespace)
+			}
+			continue
+		}
+
+		fmt.Fprintf(a.w, "  %s: found\n", ref)
+	}
+}
+
+// findReferenceGrant returns the ReferenceGrant allowing the analyzed route to reference the
+// object, or nil if there is none.
+func (a *routeAnalyzer) findReferenceGrant(group, kind, namespace, name string) *gwapiv1b1.ReferenceGrant {
+	for _, grant := range a.resources.ReferenceGrants {
+		if grant.Namespace != namespace {
+			continue
+		}
+
+		var fromAllowed bool
+		for _, from := range grant.Spec.From {
+			if string(from.Group) == gwapiv1.GroupName && string(from.Kind) == a.kind && string(from.Namespace) == a.namespace {
+				fromAllowed = true
+				break
+			}
+		}
+		if !fromAllowed {
+			continue
+		}
+
+		for _, to := range grant.Spec.To {
+			if string(to.Group) == group && string(to.Kind) == kind && (to.Name == nil || *to.Name == "" || string(*to.Name) == name) {
+				return grant
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeHTTPRoute(t *testing.T) {
+	testCases := []struct {
+		name      string
+		namespace string
+		output    string
+	}{
+		{
+			name:      "accepted",
+			namespace: "default",
+			output: `HTTPRoute default/backend
+
+ParentRef default/eg:
+  Listener http: HTTPRoute is allowed, hostnames [www.example.com]
+  Listener tcp: HTTPRoute is not allowed, supported kinds are [TCPRoute]
+  Accepted: True (Accepted) Route is accepted
+  ResolvedRefs: True (ResolvedRefs) Resolved all the Object references for the Route
+
+BackendRefs:
+  Service default/backend:3000: found
+`,
+		},
+		{
+			name:      "not-allowed-by-listeners",
+			namespace: "other",
+			output: `HTTPRoute other/backend
+
+ParentRef default/eg sectionName=http:
+  Listener http: allowedRoutes only allows routes from the Gateway namespace default
+  Listener tcp: not selected by sectionName
+  Accepted: False (NotAllowedByListeners) No listeners included by this parent ref allowed this attachment.
+  ResolvedRefs: True (ResolvedRefs) Resolved all the Object references for the Route
+
+BackendRefs:
+  Service other/backend:3000: found
+`,
+		},
+		{
+			name:      "cross-namespace-backend-ref-granted",
+			namespace: "granted",
+			output: `HTTPRoute granted/backend
+
+ParentRef default/eg sectionName=http:
+  Listener http: allowedRoutes only allows routes from the Gateway namespace default
+  Listener tcp: not selected by sectionName
+  Accepted: False (NotAllowedByListeners) No listeners included by this parent ref allowed this attachment.
+  ResolvedRefs: True (ResolvedRefs) Resolved all the Object references for the Route
+
+BackendRefs:
+  Service default/backend:3000: found, cross-namespace reference allowed by ReferenceGrant default/backend
+`,
+		},
+		{
+			name:      "cross-namespace-backend-ref-not-granted",
+			namespace: "not-granted",
+			output: `HTTPRoute not-granted/backend
+
+ParentRef default/eg sectionName=http:
+  Listener http: allowedRoutes only allows routes from the Gateway namespace default
+  Listener tcp: not selected by sectionName
+  Accepted: False (NotAllowedByListeners) No listeners included by this parent ref allowed this attachment.
+  ResolvedRefs: False (RefNotPermitted) Backend ref to Service default/backend not permitted by any ReferenceGrant.
+
+BackendRefs:
+  Service default/backend:3000: found, cross-namespace reference not allowed, no ReferenceGrant in namespace default allows HTTPRoute references from namespace not-granted
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := bytes.NewBufferString("")
+			root := newAnalyzeCommand()
+			root.SetOut(b)
+			root.SetErr(b)
+			root.SetArgs([]string{
+				"httproute",
+				"backend",
+				"--namespace",
+				tc.namespace,
+				"--file",
+				path.Join("testdata", "analyze", "httproute.yaml"),
+			})
+
+			err := root.ExecuteContext(context.Background())
+			require.NoError(t, err)
+
+			out, err := io.ReadAll(b)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, string(out))
+		})
+	}
+}
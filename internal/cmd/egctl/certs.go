@@ -0,0 +1,463 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	quicv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	kube "github.com/envoyproxy/gateway/internal/kubernetes"
+)
+
+const (
+	certStatusOK = "OK"
+	caCertKey    = "ca.crt"
+)
+
+func newCertsCommand() *cobra.Command {
+	var (
+		namespace, proxyNamespace string
+		expiryWarning             time.Duration
+	)
+
+	certsCommand := &cobra.Command{
+		Use:   "certs <gateway-name> -n <namespace>",
+		Short: "Inspect and verify the TLS configuration of a Gateway",
+		Long: `Inspect and verify the TLS configuration of a Gateway.
+The certificates referenced by the listeners are checked for their key, expiry and a match with the listener hostname,
+the CA certificates of the BackendTLSPolicies attached to the Gateway are checked for their expiry, and the certificates
+served for each SNI are read from the filter chains of the Envoy proxies of the Gateway.`,
+		Example: `  # Inspect and verify the TLS configuration of a Gateway.
+  egctl experimental certs eg -n default
+
+  # Warn about certificates expiring within a week.
+  egctl experimental certs eg -n default --expiry-warning 168h
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			return runCerts(c.Context(), c.OutOrStdout(), c.ErrOrStderr(), types.NamespacedName{Namespace: namespace, Name: args[0]}, proxyNamespace, expiryWarning)
+		},
+	}
+
+	certsCommand.PersistentFlags().StringVarP(&namespace, "namespace", "n", "default", "Namespace of the Gateway.")
+	certsCommand.PersistentFlags().StringVar(&proxyNamespace, "proxy-namespace", "envoy-gateway-system", "Namespace where envoy proxy pods are installed.")
+	certsCommand.PersistentFlags().DurationVar(&expiryWarning, "expiry-warning", 30*24*time.Hour, "Warn about certificates expiring within this duration.")
+
+	return certsCommand
+}
+
+// certCheck is the result of the verification of a certificate.
+type certCheck struct {
+	DNSNames []string
+	NotAfter time.Time
+	// Status is OK, or the list of problems found, like EXPIRED.
+	Status string
+	// Failed is whether the certificate can't be used, a certificate expiring soon is still usable.
+	Failed bool
+}
+
+func (c *certCheck) addProblem(problem string, failed bool) {
+	if c.Status == certStatusOK || c.Status == "" {
+		c.Status = problem
+	} else {
+		c.Status += ", " + problem
+	}
+	c.Failed = c.Failed || failed
+}
+
+func runCerts(ctx context.Context, w, errW io.Writer, gatewayNN types.NamespacedName, proxyNamespace string, expiryWarning time.Duration) error {
+	k8sClient, err := newK8sClient()
+	if err != nil {
+		return err
+	}
+	gateway := &gwapiv1.Gateway{}
+	if err := k8sClient.Get(ctx, gatewayNN, gateway); err != nil {
+		return fmt.Errorf("failed to get Gateway %s: %w", gatewayNN, err)
+	}
+
+	now := time.Now()
+	listenerRows, listenersFailed := listenerCertRows(ctx, k8sClient, gateway, now, expiryWarning)
+	backendRows, backendsFailed, err := backendTLSPolicyRows(ctx, k8sClient, gateway, now, expiryWarning)
+	if err != nil {
+		return err
+	}
+
+	var sniRows [][]string
+	kubeClient, err := getCLIClient()
+	if err != nil {
+		return err
+	}
+	if configDump, err := gatewayProxyConfigDump(kubeClient, gateway, proxyNamespace); err != nil {
+		_, _ = fmt.Fprintf(errW, "WARNING: unable to read the filter chains of the proxies: %v\n", err)
+	} else if sniRows, err = filterChainCertRows(configDump); err != nil {
+		return err
+	}
+
+	table := newStatusTableWriter(w)
+	writeStatusTable(table, []string{"LISTENER", "HOSTNAME", "CERTIFICATE", "DNS NAMES", "NOT AFTER", "STATUS"}, listenerRows)
+	_, _ = fmt.Fprintln(table)
+	writeStatusTable(table, []string{"BACKENDTLSPOLICY", "HOSTNAME", "CA CERTIFICATE", "NOT AFTER", "STATUS"}, backendRows)
+	_, _ = fmt.Fprintln(table)
+	writeStatusTable(table, []string{"LISTENER", "FILTER CHAIN", "SERVER NAMES", "CERTIFICATES"}, sniRows)
+	_ = table.Flush()
+
+	if listenersFailed || backendsFailed {
+		return errors.New("invalid certificates found")
+	}
+	return nil
+}
+
+// listenerCertRows verifies the certificates referenced by the TLS listeners of the Gateway.
+func listenerCertRows(ctx context.Context, cli client.Client, gateway *gwapiv1.Gateway, now time.Time, expiryWarning time.Duration) ([][]string, bool) {
+	var (
+		rows   [][]string
+		failed bool
+	)
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		hostname := ""
+		if listener.Hostname != nil {
+			hostname = string(*listener.Hostname)
+		}
+
+		for _, ref := range listener.TLS.CertificateRefs {
+			nn := types.NamespacedName{Namespace: gateway.Namespace, Name: string(ref.Name)}
+			if ref.Namespace != nil {
+				nn.Namespace = string(*ref.Namespace)
+			}
+
+			check := &certCheck{}
+			switch {
+			case ref.Kind != nil && string(*ref.Kind) != resource.KindSecret,
+				ref.Group != nil && *ref.Group != corev1.GroupName:
+				check.addProblem("UNSUPPORTED KIND", true)
+			default:
+				secret := &corev1.Secret{}
+				if err := cli.Get(ctx, nn, secret); err != nil {
+					check.addProblem(fmt.Sprintf("NOT FOUND: %v", err), true)
+				} else {
+					check = checkCertificate(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], hostname, now, expiryWarning)
+				}
+			}
+
+			failed = failed || check.Failed
+			rows = append(rows, []string{
+				string(listener.Name),
+				valueOrDash(hostname),
+				nn.String(),
+				valueOrDash(strings.Join(check.DNSNames, ",")),
+				formatNotAfter(check.NotAfter),
+				check.Status,
+			})
+		}
+	}
+	return rows, failed
+}
+
+// checkCertificate verifies that the certificate chain matches the key, is valid at the given time,
+// and covers the hostname of the listener.
+func checkCertificate(certPEM, keyPEM []byte, hostname string, now time.Time, expiryWarning time.Duration) *certCheck {
+	check := &certCheck{Status: certStatusOK}
+
+	leaf, err := parseFirstCertificate(certPEM)
+	if err != nil {
+		check.addProblem(fmt.Sprintf("INVALID: %v", err), true)
+		return check
+	}
+	check.DNSNames, check.NotAfter = leaf.DNSNames, leaf.NotAfter
+
+	if len(keyPEM) == 0 {
+		check.addProblem("MISSING KEY", true)
+	} else if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		check.addProblem(fmt.Sprintf("INVALID KEY: %v", err), true)
+	}
+
+	checkValidity(check, leaf, now, expiryWarning)
+
+	if hostname != "" && !certificateCoversHostname(leaf, hostname) {
+		check.addProblem("HOSTNAME MISMATCH", true)
+	}
+	return check
+}
+
+func checkValidity(check *certCheck, cert *x509.Certificate, now time.Time, expiryWarning time.Duration) {
+	switch {
+	case now.After(cert.NotAfter):
+		check.addProblem("EXPIRED", true)
+	case now.Before(cert.NotBefore):
+		check.addProblem("NOT YET VALID", true)
+	case now.Add(expiryWarning).After(cert.NotAfter):
+		check.addProblem(fmt.Sprintf("EXPIRES IN %dd", int(cert.NotAfter.Sub(now).Hours()/24)), false)
+	}
+}
+
+// certificateCoversHostname returns whether the certificate is valid for all the hosts matched by the hostname.
+func certificateCoversHostname(cert *x509.Certificate, hostname string) bool {
+	if !strings.HasPrefix(hostname, "*.") {
+		return cert.VerifyHostname(hostname) == nil
+	}
+	// A wildcard hostname is only covered by the same wildcard.
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, hostname) {
+			return true
+		}
+	}
+	return false
+}
+
+// backendTLSPolicyRows verifies the CA certificates of the BackendTLSPolicies attached to the Gateway.
+func backendTLSPolicyRows(ctx context.Context, cli client.Client, gateway *gwapiv1.Gateway, now time.Time, expiryWarning time.Duration) ([][]string, bool, error) {
+	policies := gwapiv1a3.BackendTLSPolicyList{}
+	if err := cli.List(ctx, &policies); err != nil {
+		return nil, false, err
+	}
+
+	var (
+		rows   [][]string
+		failed bool
+	)
+	gatewayNN := types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		attached := false
+		for _, ancestor := range policy.Status.Ancestors {
+			if ancestor.AncestorRef.Kind != nil && string(*ancestor.AncestorRef.Kind) != resource.KindGateway {
+				continue
+			}
+			if parentRefNamespacedName(ancestor.AncestorRef, policy.Namespace) == gatewayNN {
+				attached = true
+			}
+		}
+		if !attached {
+			continue
+		}
+
+		policyNN := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+		validation := policy.Spec.Validation
+		if validation.WellKnownCACertificates != nil {
+			rows = append(rows, []string{policyNN.String(), string(validation.Hostname), string(*validation.WellKnownCACertificates), "-", certStatusOK})
+		}
+		for _, ref := range validation.CACertificateRefs {
+			check := checkCACertificateRef(ctx, cli, policy.Namespace, ref, now, expiryWarning)
+			failed = failed || check.Failed
+			rows = append(rows, []string{
+				policyNN.String(),
+				string(validation.Hostname),
+				fmt.Sprintf("%s/%s", ref.Kind, ref.Name),
+				formatNotAfter(check.NotAfter),
+				check.Status,
+			})
+		}
+	}
+	return rows, failed, nil
+}
+
+func checkCACertificateRef(ctx context.Context, cli client.Client, namespace string, ref gwapiv1.LocalObjectReference, now time.Time, expiryWarning time.Duration) *certCheck {
+	check := &certCheck{Status: certStatusOK}
+	nn := types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+
+	var bundle []byte
+	switch string(ref.Kind) {
+	case resource.KindConfigMap:
+		cm := &corev1.ConfigMap{}
+		if err := cli.Get(ctx, nn, cm); err != nil {
+			check.addProblem(fmt.Sprintf("NOT FOUND: %v", err), true)
+			return check
+		}
+		bundle = []byte(cm.Data[caCertKey])
+	case resource.KindSecret:
+		secret := &corev1.Secret{}
+		if err := cli.Get(ctx, nn, secret); err != nil {
+			check.addProblem(fmt.Sprintf("NOT FOUND: %v", err), true)
+			return check
+		}
+		bundle = secret.Data[caCertKey]
+	default:
+		check.addProblem("UNSUPPORTED KIND", true)
+		return check
+	}
+
+	certs, err := parseCertificates(bundle)
+	if err != nil {
+		check.addProblem(fmt.Sprintf("INVALID: %v", err), true)
+		return check
+	}
+	// The bundle is as valid as its first certificate to expire.
+	first := certs[0]
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(first.NotAfter) {
+			first = cert
+		}
+	}
+	check.NotAfter = first.NotAfter
+	checkValidity(check, first, now, expiryWarning)
+	return check
+}
+
+func parseFirstCertificate(data []byte) (*x509.Certificate, error) {
+	certs, err := parseCertificates(data)
+	if err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+// parseCertificates parses the certificates of a PEM bundle.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return certs, nil
+}
+
+// gatewayProxyConfigDump returns the config dump of a running Envoy proxy of the Gateway,
+// all the proxies of a Gateway share the same configuration.
+func gatewayProxyConfigDump(cli kube.CLIClient, gateway *gwapiv1.Gateway, proxyNamespace string) (*adminv3.ConfigDump, error) {
+	var selectors []string
+	for k, v := range gatewayapi.GatewayOwnerLabels(gateway.Namespace, gateway.Name) {
+		selectors = append(selectors, k+"="+v)
+	}
+	pods, err := cli.PodsForSelector(proxyNamespace, selectors...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			return proxyConfigDump(cli, types.NamespacedName{Namespace: pods.Items[i].Namespace, Name: pods.Items[i].Name})
+		}
+	}
+	return nil, fmt.Errorf("no running envoy proxy pods found for Gateway %s/%s in namespace %s", gateway.Namespace, gateway.Name, proxyNamespace)
+}
+
+// filterChainCertRows returns the server names and the certificates of the TLS filter chains of the listeners.
+func filterChainCertRows(configDump *adminv3.ConfigDump) ([][]string, error) {
+	var rows [][]string
+	for _, config := range configDump.Configs {
+		if !config.MessageIs(&adminv3.ListenersConfigDump{}) {
+			continue
+		}
+		listeners := &adminv3.ListenersConfigDump{}
+		if err := config.UnmarshalTo(listeners); err != nil {
+			return nil, err
+		}
+
+		for _, dynamicListener := range listeners.DynamicListeners {
+			if dynamicListener.ActiveState == nil {
+				continue
+			}
+			listener := &listenerv3.Listener{}
+			if err := dynamicListener.ActiveState.Listener.UnmarshalTo(listener); err != nil {
+				return nil, err
+			}
+
+			filterChains := listener.FilterChains
+			if listener.DefaultFilterChain != nil {
+				filterChains = append(filterChains, listener.DefaultFilterChain)
+			}
+			for _, filterChain := range filterChains {
+				secrets, err := filterChainCertificates(filterChain)
+				if err != nil {
+					return nil, err
+				}
+				if len(secrets) == 0 {
+					continue
+				}
+				serverNames := "*"
+				if len(filterChain.GetFilterChainMatch().GetServerNames()) > 0 {
+					serverNames = strings.Join(filterChain.FilterChainMatch.ServerNames, ",")
+				}
+				rows = append(rows, []string{listener.Name, valueOrDash(filterChain.Name), serverNames, strings.Join(secrets, ",")})
+			}
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+	return rows, nil
+}
+
+// filterChainCertificates returns the names of the certificates served by the filter chain.
+func filterChainCertificates(filterChain *listenerv3.FilterChain) ([]string, error) {
+	typedConfig := filterChain.GetTransportSocket().GetTypedConfig()
+	if typedConfig == nil {
+		return nil, nil
+	}
+
+	var tlsContext *tlsv3.DownstreamTlsContext
+	switch {
+	case typedConfig.MessageIs(&tlsv3.DownstreamTlsContext{}):
+		tlsContext = &tlsv3.DownstreamTlsContext{}
+		if err := typedConfig.UnmarshalTo(tlsContext); err != nil {
+			return nil, err
+		}
+	case typedConfig.MessageIs(&quicv3.QuicDownstreamTransport{}):
+		quic := &quicv3.QuicDownstreamTransport{}
+		if err := typedConfig.UnmarshalTo(quic); err != nil {
+			return nil, err
+		}
+		tlsContext = quic.DownstreamTlsContext
+	default:
+		return nil, nil
+	}
+
+	var secrets []string
+	for _, sds := range tlsContext.GetCommonTlsContext().GetTlsCertificateSdsSecretConfigs() {
+		secrets = append(secrets, sds.Name)
+	}
+	return secrets, nil
+}
+
+func formatNotAfter(notAfter time.Time) string {
+	if notAfter.IsZero() {
+		return "-"
+	}
+	return notAfter.UTC().Format(time.RFC3339)
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,178 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestCertificate(t *testing.T, notAfter time.Time, dnsNames ...string) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		DNSNames:     dnsNames,
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCheckCertificate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid, validKey := newTestCertificate(t, now.Add(90*24*time.Hour), "www.example.com", "*.example.com")
+	expiring, expiringKey := newTestCertificate(t, now.Add(10*24*time.Hour), "www.example.com")
+	expired, expiredKey := newTestCertificate(t, now.Add(-time.Hour), "www.example.com")
+
+	cases := []struct {
+		name     string
+		cert     []byte
+		key      []byte
+		hostname string
+		status   string
+		failed   bool
+	}{
+		{
+			name:     "valid",
+			cert:     valid,
+			key:      validKey,
+			hostname: "www.example.com",
+			status:   "OK",
+		},
+		{
+			name:     "valid-wildcard",
+			cert:     valid,
+			key:      validKey,
+			hostname: "*.example.com",
+			status:   "OK",
+		},
+		{
+			name:   "no-hostname",
+			cert:   valid,
+			key:    validKey,
+			status: "OK",
+		},
+		{
+			name:     "hostname-mismatch",
+			cert:     expiring,
+			key:      expiringKey,
+			hostname: "*.example.com",
+			status:   "EXPIRES IN 10d, HOSTNAME MISMATCH",
+			failed:   true,
+		},
+		{
+			name:     "expiring",
+			cert:     expiring,
+			key:      expiringKey,
+			hostname: "www.example.com",
+			status:   "EXPIRES IN 10d",
+		},
+		{
+			name:     "expired",
+			cert:     expired,
+			key:      expiredKey,
+			hostname: "www.example.com",
+			status:   "EXPIRED",
+			failed:   true,
+		},
+		{
+			name:     "key-mismatch",
+			cert:     valid,
+			key:      expiredKey,
+			hostname: "www.example.com",
+			status:   "INVALID KEY: tls: private key does not match public key",
+			failed:   true,
+		},
+		{
+			name:     "missing-key",
+			cert:     valid,
+			hostname: "www.example.com",
+			status:   "MISSING KEY",
+			failed:   true,
+		},
+		{
+			name:   "invalid-certificate",
+			cert:   []byte("invalid"),
+			key:    validKey,
+			status: "INVALID: no certificate found",
+			failed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			check := checkCertificate(tc.cert, tc.key, tc.hostname, now, 30*24*time.Hour)
+			require.Equal(t, tc.status, check.Status)
+			require.Equal(t, tc.failed, check.Failed)
+		})
+	}
+}
+
+func TestFilterChainCertRows(t *testing.T) {
+	tlsContext, err := anypb.New(&tlsv3.DownstreamTlsContext{
+		CommonTlsContext: &tlsv3.CommonTlsContext{
+			TlsCertificateSdsSecretConfigs: []*tlsv3.SdsSecretConfig{
+				{Name: "default/example-cert"},
+				{Name: "default/example-cert-ecdsa"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	listener, err := anypb.New(&listenerv3.Listener{
+		Name: "default/eg/https",
+		FilterChains: []*listenerv3.FilterChain{
+			{
+				Name:             "default/eg/https",
+				FilterChainMatch: &listenerv3.FilterChainMatch{ServerNames: []string{"www.example.com"}},
+				TransportSocket: &corev3.TransportSocket{
+					Name:       "envoy.transport_sockets.tls",
+					ConfigType: &corev3.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+				},
+			},
+			{
+				Name: "default/eg/http",
+			},
+		},
+	})
+	require.NoError(t, err)
+	listeners, err := anypb.New(&adminv3.ListenersConfigDump{
+		DynamicListeners: []*adminv3.ListenersConfigDump_DynamicListener{
+			{
+				Name:        "default/eg/https",
+				ActiveState: &adminv3.ListenersConfigDump_DynamicListenerState{Listener: listener},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rows, err := filterChainCertRows(&adminv3.ConfigDump{Configs: []*anypb.Any{listeners}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"default/eg/https", "default/eg/https", "www.example.com", "default/example-cert,default/example-cert-ecdsa"},
+	}, rows)
+}
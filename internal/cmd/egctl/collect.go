@@ -15,6 +15,7 @@ import (
 	"syscall"
 	"time"
 
+	tbcollect "github.com/replicatedhq/troubleshoot/pkg/collect"
 	"github.com/spf13/cobra"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
@@ -26,6 +27,7 @@ import (
 type collectOptions struct {
 	outPath               string
 	envoyGatewayNamespace string
+	redact                bool
 }
 
 func newCollectCommand() *cobra.Command {
@@ -35,6 +37,9 @@ func newCollectCommand() *cobra.Command {
 		Short: "Collect configurations from the cluster to help diagnose any issues offline",
 		Example: `  # Collect configurations from current context.
   egctl experimental collect
+
+  # Collect configurations without redacting sensitive values like passwords and tokens.
+  egctl experimental collect --redact=false
 	`,
 		Run: func(c *cobra.Command, args []string) {
 			cmdutil.CheckErr(runCollect(*collectOpts))
@@ -48,6 +53,8 @@ func newCollectCommand() *cobra.Command {
 		"Specify the output file path for collected data. If not specified, a timestamped file will be created in the current directory.")
 	collectCommand.PersistentFlags().StringVarP(&collectOpts.envoyGatewayNamespace, "envoy-system-namespace", "", "envoy-gateway-system",
 		"Specify the namespace where the Envoy Gateway controller is installed.")
+	collectCommand.PersistentFlags().BoolVarP(&collectOpts.redact, "redact", "", true,
+		"Redact sensitive values like passwords, tokens and connection strings from the collected data.")
 
 	return collectCommand
 }
@@ -89,6 +96,11 @@ func runCollect(collectOpts collectOptions) error {
 	}
 
 	result := tb.CollectResult(ctx, restConfig, bundlePath, collectOpts.envoyGatewayNamespace)
+	if collectOpts.redact {
+		if err := tbcollect.RedactResult(bundlePath, result, nil); err != nil {
+			return fmt.Errorf("redact support bundle: %w", err)
+		}
+	}
 	return result.ArchiveSupportBundle(bundlePath, fmt.Sprintf("%s.tar.gz", basename))
 }
 
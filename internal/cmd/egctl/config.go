@@ -34,6 +34,7 @@ var (
 	podNamespace   string
 	labelSelectors []string
 	allNamespaces  bool
+	nameRegex      string
 )
 
 const (
@@ -44,7 +45,7 @@ const (
 
 type aggregatedConfigDump map[string]map[string]protoreflect.ProtoMessage
 
-func retrieveConfigDump(args []string, includeEds bool, configType envoyConfigType) (aggregatedConfigDump, error) {
+func retrieveConfigDump(args []string, includeEds bool, configType envoyConfigType, filter *configDumpFilter) (aggregatedConfigDump, error) {
 	if !allNamespaces {
 		if len(labelSelectors) == 0 {
 			if len(args) != 0 && args[0] != "" {
@@ -99,6 +100,13 @@ func retrieveConfigDump(args []string, includeEds bool, configType envoyConfigTy
 				return
 			}
 
+			if filter != nil {
+				if configDump, err = filter.apply(configDump); err != nil {
+					errs = errors.Join(errs, err)
+					return
+				}
+			}
+
 			podConfigDumps[pod.Namespace][pod.Name] = configDump
 		}()
 	}
@@ -214,11 +222,18 @@ func marshalEnvoyProxyConfig(configDump aggregatedConfigDump, output string) ([]
 	}
 
 	out, err := json.MarshalIndent(configDumpMap, "", "  ")
-	if output == "yaml" {
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case output == "yaml":
 		return yaml.JSONToYAML(out)
+	case isJSONPathOutput(output):
+		return printJSONPath(out, output)
 	}
 
-	return out, err
+	return out, nil
 }
 
 func extractConfigDump(fw kube.PortForwarder, includeEds bool, configType envoyConfigType) (protoreflect.ProtoMessage, error) {
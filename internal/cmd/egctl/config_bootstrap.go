@@ -39,7 +39,12 @@ func bootstrapConfigCmd() *cobra.Command {
 }
 
 func runBootstrapConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, false, BootstrapEnvoyConfigType)
+	filter, err := newConfigDumpFilter(nameRegex, "")
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, false, BootstrapEnvoyConfigType, filter)
 	if err != nil {
 		return err
 	}
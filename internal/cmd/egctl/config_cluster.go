@@ -39,7 +39,12 @@ func clusterConfigCmd() *cobra.Command {
 }
 
 func runClusterConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, false, ClusterEnvoyConfigType)
+	filter, err := newConfigDumpFilter(nameRegex, "")
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, false, ClusterEnvoyConfigType, filter)
 	if err != nil {
 		return err
 	}
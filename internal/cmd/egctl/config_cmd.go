@@ -28,7 +28,7 @@ func newConfigCommand() *cobra.Command {
 	flags := cfgCommand.Flags()
 	options.AddKubeConfigFlags(flags)
 
-	cfgCommand.PersistentFlags().StringVarP(&output, "output", "o", "json", "One of 'yaml' or 'json'")
+	cfgCommand.PersistentFlags().StringVarP(&output, "output", "o", "json", "One of 'yaml', 'json' or 'jsonpath=<template>'")
 	cfgCommand.PersistentFlags().StringVarP(&podNamespace, "namespace", "n", "envoy-gateway-system", "Namespace where envoy proxy pod are installed.")
 	cfgCommand.PersistentFlags().StringArrayVarP(&labelSelectors, "labels", "l", nil, "Labels to select the envoy proxy pod.")
 	cfgCommand.PersistentFlags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List all envoy proxy pods from all namespaces.")
@@ -53,11 +53,16 @@ func proxyCommand() *cobra.Command {
 	c.AddCommand(endpointConfigCmd())
 	c.AddCommand(listenerConfigCmd())
 	c.AddCommand(routeConfigCmd())
+	c.AddCommand(xRouteConfigCmd())
+
+	c.PersistentFlags().StringVar(&nameRegex, "name-regex", "", "Only retrieve the xDS resources whose name matches the regex.")
 
 	return c
 }
 
 func allConfigCmd() *cobra.Command {
+	var routeRegex, clusterRegex string
+
 	configCmd := &cobra.Command{
 		Use:   "all <pod-name>",
 		Short: "Retrieves all Envoy xDS resources from the specified pod",
@@ -73,17 +78,58 @@ func allConfigCmd() *cobra.Command {
 
   # Retrieve full configuration dump with short syntax
   egctl c proxy all <pod-name> -n <pod-namespace>
+
+  # Retrieve the xDS resources generated for a HTTPRoute
+  egctl config envoy-proxy all <pod-name> -n <pod-namespace> --name-regex 'httproute/default/backend/'
+
+  # Retrieve the routes generated for a HTTPRoute
+  egctl config envoy-proxy all <pod-name> -n <pod-namespace> --route 'httproute/default/backend/'
+
+  # Retrieve the clusters generated for a HTTPRoute
+  egctl config envoy-proxy all <pod-name> -n <pod-namespace> --cluster 'httproute/default/backend/'
+
+  # Retrieve the names of the dynamic listeners
+  egctl config envoy-proxy listener <pod-name> -n <pod-namespace> -o 'jsonpath={..dynamicListeners[*].name}'
 `,
 		Run: func(c *cobra.Command, args []string) {
-			cmdutil.CheckErr(runAllConfig(c, args))
+			cmdutil.CheckErr(runAllConfig(c, args, routeRegex, clusterRegex))
 		},
 	}
 
+	configCmd.Flags().StringVar(&routeRegex, "route", "", "Shorthand to only retrieve the routes whose name matches the regex.")
+	configCmd.Flags().StringVar(&clusterRegex, "cluster", "", "Shorthand to only retrieve the clusters whose name matches the regex.")
+
 	return configCmd
 }
 
-func runAllConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, true, AllEnvoyConfigType)
+func runAllConfig(c *cobra.Command, args []string, routeRegex, clusterRegex string) error {
+	var (
+		includeEds = true
+		configType = AllEnvoyConfigType
+		filter     *configDumpFilter
+		err        error
+	)
+
+	switch {
+	case routeRegex != "" && clusterRegex != "":
+		return fmt.Errorf("only one of --route and --cluster can be specified")
+	case routeRegex != "":
+		includeEds, configType = false, RouteEnvoyConfigType
+		filter, err = newConfigDumpFilter(nameRegex, routeRegex)
+	case clusterRegex != "":
+		if nameRegex != "" {
+			return fmt.Errorf("--name-regex and --cluster cannot be specified together")
+		}
+		includeEds, configType = false, ClusterEnvoyConfigType
+		filter, err = newConfigDumpFilter(clusterRegex, "")
+	default:
+		filter, err = newConfigDumpFilter(nameRegex, "")
+	}
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, includeEds, configType, filter)
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,12 @@ func endpointConfigCmd() *cobra.Command {
 }
 
 func runEndpointConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, true, EndpointEnvoyConfigType)
+	filter, err := newConfigDumpFilter(nameRegex, "")
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, true, EndpointEnvoyConfigType, filter)
 	if err != nil {
 		return err
 	}
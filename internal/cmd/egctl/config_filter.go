@@ -0,0 +1,236 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const jsonPathOutputPrefix = "jsonpath="
+
+// configDumpFilter narrows down a config dump to the xDS resources a user is interested in.
+type configDumpFilter struct {
+	// name only keeps the top level xDS resources (listeners, clusters, route configurations,
+	// endpoints and secrets) with a matching name.
+	name *regexp.Regexp
+	// route only keeps the routes with a matching name, and the virtual hosts and route
+	// configurations containing them.
+	route *regexp.Regexp
+}
+
+func newConfigDumpFilter(nameRegex, routeRegex string) (*configDumpFilter, error) {
+	if nameRegex == "" && routeRegex == "" {
+		return nil, nil
+	}
+
+	f := &configDumpFilter{}
+	if nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name regex %q: %w", nameRegex, err)
+		}
+		f.name = re
+	}
+	if routeRegex != "" {
+		re, err := regexp.Compile(routeRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid route regex %q: %w", routeRegex, err)
+		}
+		f.route = re
+	}
+	return f, nil
+}
+
+// apply filters the config dump returned by findXDSResourceFromConfigDump, which is either
+// the whole ConfigDump or a single config dump wrapped in an Any.
+func (f *configDumpFilter) apply(configDump protoreflect.ProtoMessage) (protoreflect.ProtoMessage, error) {
+	switch cd := configDump.(type) {
+	case *adminv3.ConfigDump:
+		for i, cfg := range cd.Configs {
+			filtered, err := f.filterAny(cfg)
+			if err != nil {
+				return nil, err
+			}
+			cd.Configs[i] = filtered
+		}
+		return cd, nil
+	case *anypb.Any:
+		return f.filterAny(cd)
+	default:
+		return configDump, nil
+	}
+}
+
+func (f *configDumpFilter) filterAny(cfg *anypb.Any) (*anypb.Any, error) {
+	dump, err := cfg.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	if f.name != nil {
+		filterByName(dump.ProtoReflect(), f.name)
+	}
+	if routes, ok := dump.(*adminv3.RoutesConfigDump); ok && f.route != nil {
+		if err := filterRoutes(routes, f.route); err != nil {
+			return nil, err
+		}
+	}
+
+	return anypb.New(dump)
+}
+
+// filterByName removes the entries of all the repeated fields of the config dump whose
+// xDS resource name doesn't match the regex.
+func filterByName(dump protoreflect.Message, re *regexp.Regexp) {
+	var lists []protoreflect.FieldDescriptor
+	dump.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsList() && fd.Message() != nil {
+			lists = append(lists, fd)
+		}
+		return true
+	})
+
+	for _, fd := range lists {
+		list := dump.Get(fd).List()
+		kept := dump.NewField(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			if re.MatchString(configEntryName(list.Get(i).Message())) {
+				kept.Append(list.Get(i))
+			}
+		}
+		dump.Set(fd, protoreflect.ValueOfList(kept))
+	}
+}
+
+// configEntryName returns the name of the xDS resource held by a config dump entry, either
+// from the name of the entry itself or from the resource wrapped in it.
+func configEntryName(entry protoreflect.Message) string {
+	if name := stringField(entry, "name"); name != "" {
+		return name
+	}
+
+	var name string
+	entry.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() || fd.Message() == nil || fd.Message().FullName() != "google.protobuf.Any" {
+			return true
+		}
+		resource, err := v.Message().Interface().(*anypb.Any).UnmarshalNew()
+		if err != nil {
+			return true
+		}
+		if name = stringField(resource.ProtoReflect(), "name"); name == "" {
+			name = stringField(resource.ProtoReflect(), "cluster_name")
+		}
+		return name == ""
+	})
+	return name
+}
+
+func stringField(m protoreflect.Message, field protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(field)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+// filterRoutes only keeps the routes whose name matches the regex, dropping the virtual hosts
+// and route configurations left without any route.
+func filterRoutes(dump *adminv3.RoutesConfigDump, re *regexp.Regexp) error {
+	var static []*adminv3.RoutesConfigDump_StaticRouteConfig
+	for _, rc := range dump.StaticRouteConfigs {
+		filtered, err := filterRouteConfiguration(rc.RouteConfig, re)
+		if err != nil {
+			return err
+		}
+		if filtered != nil {
+			rc.RouteConfig = filtered
+			static = append(static, rc)
+		}
+	}
+	dump.StaticRouteConfigs = static
+
+	var dynamic []*adminv3.RoutesConfigDump_DynamicRouteConfig
+	for _, rc := range dump.DynamicRouteConfigs {
+		filtered, err := filterRouteConfiguration(rc.RouteConfig, re)
+		if err != nil {
+			return err
+		}
+		if filtered != nil {
+			rc.RouteConfig = filtered
+			dynamic = append(dynamic, rc)
+		}
+	}
+	dump.DynamicRouteConfigs = dynamic
+
+	return nil
+}
+
+func filterRouteConfiguration(cfg *anypb.Any, re *regexp.Regexp) (*anypb.Any, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	rc := &routev3.RouteConfiguration{}
+	if err := cfg.UnmarshalTo(rc); err != nil {
+		return nil, err
+	}
+
+	var vhosts []*routev3.VirtualHost
+	for _, vh := range rc.VirtualHosts {
+		var routes []*routev3.Route
+		for _, r := range vh.Routes {
+			if re.MatchString(r.Name) {
+				routes = append(routes, r)
+			}
+		}
+		if len(routes) > 0 {
+			vh.Routes = routes
+			vhosts = append(vhosts, vh)
+		}
+	}
+	if len(vhosts) == 0 {
+		return nil, nil
+	}
+	rc.VirtualHosts = vhosts
+
+	return anypb.New(rc)
+}
+
+// isJSONPathOutput returns whether the output format is a JSONPath template.
+func isJSONPathOutput(output string) bool {
+	return strings.HasPrefix(output, jsonPathOutputPrefix)
+}
+
+// printJSONPath evaluates the JSONPath template of the output format against the JSON document.
+func printJSONPath(in []byte, output string) ([]byte, error) {
+	j := jsonpath.New("output")
+	j.AllowMissingKeys(true)
+	if err := j.Parse(strings.TrimPrefix(output, jsonPathOutputPrefix)); err != nil {
+		return nil, fmt.Errorf("invalid jsonpath template: %w", err)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(in, &data); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := j.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
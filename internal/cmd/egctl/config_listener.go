@@ -39,7 +39,12 @@ func listenerConfigCmd() *cobra.Command {
 }
 
 func runListenerConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, false, ListenerEnvoyConfigType)
+	filter, err := newConfigDumpFilter(nameRegex, "")
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, false, ListenerEnvoyConfigType, filter)
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,12 @@ func routeConfigCmd() *cobra.Command {
 }
 
 func runRouteConfig(c *cobra.Command, args []string) error {
-	configDump, err := retrieveConfigDump(args, false, RouteEnvoyConfigType)
+	filter, err := newConfigDumpFilter(nameRegex, "")
+	if err != nil {
+		return err
+	}
+
+	configDump, err := retrieveConfigDump(args, false, RouteEnvoyConfigType, filter)
 	if err != nil {
 		return err
 	}
@@ -13,11 +13,17 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"testing"
 
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -222,6 +228,199 @@ func TestExtractSubResourcesConfigDump(t *testing.T) {
 	fw.Stop()
 }
 
+func TestFilterConfigDump(t *testing.T) {
+	input, err := readInputConfig("in.all.json")
+	require.NoError(t, err)
+	fw, err := newFakePortForwarder(input)
+	require.NoError(t, err)
+	err = fw.Start()
+	require.NoError(t, err)
+
+	cases := []struct {
+		name         string
+		resourceType envoyConfigType
+		nameRegex    string
+		output       string
+		expected     string
+	}{
+		{
+			name:         "cluster by name",
+			resourceType: ClusterEnvoyConfigType,
+			nameRegex:    "^xds_cluster$",
+			output:       "jsonpath={..staticClusters[*].cluster.name}",
+			expected:     "xds_cluster",
+		},
+		{
+			name:         "dynamic cluster by name",
+			resourceType: AllEnvoyConfigType,
+			nameRegex:    "www.example.com",
+			output:       "jsonpath={..dynamicActiveClusters[*].cluster.name}",
+			expected:     "default-backend-rule-0-match-0-www.example.com",
+		},
+		{
+			name:         "listener by name",
+			resourceType: ListenerEnvoyConfigType,
+			nameRegex:    "eg-http",
+			output:       "jsonpath={..dynamicListeners[*].name}",
+			expected:     "default-eg-http",
+		},
+		{
+			name:         "no matching listener",
+			resourceType: ListenerEnvoyConfigType,
+			nameRegex:    "foo",
+			output:       "jsonpath={..dynamicListeners[*].name}",
+			expected:     "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := newConfigDumpFilter(tc.nameRegex, "")
+			require.NoError(t, err)
+			configDump, err := extractConfigDump(fw, false, tc.resourceType)
+			require.NoError(t, err)
+			configDump, err = filter.apply(configDump)
+			require.NoError(t, err)
+			got, err := marshalEnvoyProxyConfig(sampleAggregatedConfigDump(configDump), tc.output)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(got))
+		})
+	}
+
+	fw.Stop()
+}
+
+func TestFilterRoutes(t *testing.T) {
+	routeConfig, err := anypb.New(&routev3.RouteConfiguration{
+		Name: "default/eg/http",
+		VirtualHosts: []*routev3.VirtualHost{
+			{
+				Name: "default/eg/http/www_example_com",
+				Routes: []*routev3.Route{
+					{Name: "httproute/default/backend/rule/0/match/0/www_example_com"},
+					{Name: "httproute/default/other/rule/0/match/0/www_example_com"},
+				},
+			},
+			{
+				Name: "default/eg/http/foo_example_com",
+				Routes: []*routev3.Route{
+					{Name: "httproute/default/other/rule/0/match/0/foo_example_com"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	dump := &adminv3.RoutesConfigDump{
+		DynamicRouteConfigs: []*adminv3.RoutesConfigDump_DynamicRouteConfig{
+			{RouteConfig: routeConfig},
+		},
+	}
+	require.NoError(t, filterRoutes(dump, regexp.MustCompile("^httproute/default/backend/")))
+	require.Len(t, dump.DynamicRouteConfigs, 1)
+
+	got := &routev3.RouteConfiguration{}
+	require.NoError(t, dump.DynamicRouteConfigs[0].RouteConfig.UnmarshalTo(got))
+	require.Len(t, got.VirtualHosts, 1)
+	require.Equal(t, "default/eg/http/www_example_com", got.VirtualHosts[0].Name)
+	require.Len(t, got.VirtualHosts[0].Routes, 1)
+	require.Equal(t, "httproute/default/backend/rule/0/match/0/www_example_com", got.VirtualHosts[0].Routes[0].Name)
+
+	require.NoError(t, filterRoutes(dump, regexp.MustCompile("^httproute/default/missing/")))
+	require.Empty(t, dump.DynamicRouteConfigs)
+}
+
+func TestXRouteRuleMappings(t *testing.T) {
+	routeMetadata := func(kind, name, sectionName string) *corev3.Metadata {
+		fields := map[string]any{"kind": kind, "namespace": "default", "name": name}
+		if sectionName != "" {
+			fields["sectionName"] = sectionName
+		}
+		resource, err := structpb.NewStruct(fields)
+		require.NoError(t, err)
+		return &corev3.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{
+				"envoy-gateway": {
+					Fields: map[string]*structpb.Value{
+						"resources": structpb.NewListValue(&structpb.ListValue{
+							Values: []*structpb.Value{structpb.NewStructValue(resource)},
+						}),
+					},
+				},
+			},
+		}
+	}
+
+	routeConfig, err := anypb.New(&routev3.RouteConfiguration{
+		Name: "default/eg/http",
+		VirtualHosts: []*routev3.VirtualHost{
+			{
+				Name: "default/eg/http/www_example_com",
+				Routes: []*routev3.Route{
+					{
+						Name:     "httproute/default/backend/rule/0/match/0/www_example_com",
+						Metadata: routeMetadata("HTTPRoute", "backend", ""),
+						Action: &routev3.Route_Route{Route: &routev3.RouteAction{
+							ClusterSpecifier: &routev3.RouteAction_Cluster{Cluster: "httproute/default/backend/rule/0"},
+						}},
+					},
+					{
+						Name:     "httproute/default/backend/rule/1/match/0/www_example_com",
+						Metadata: routeMetadata("HTTPRoute", "backend", "canary"),
+						Action: &routev3.Route_Route{Route: &routev3.RouteAction{
+							ClusterSpecifier: &routev3.RouteAction_WeightedClusters{WeightedClusters: &routev3.WeightedCluster{
+								Clusters: []*routev3.WeightedCluster_ClusterWeight{
+									{Name: "httproute/default/backend/rule/1/backend/0"},
+									{Name: "httproute/default/backend/rule/1/backend/1"},
+								},
+							}},
+						}},
+					},
+					{
+						Name:     "grpcroute/default/backend/rule/0/match/0/www_example_com",
+						Metadata: routeMetadata("GRPCRoute", "backend", ""),
+					},
+					{
+						Name:     "httproute/default/other/rule/0/match/0/www_example_com",
+						Metadata: routeMetadata("HTTPRoute", "other", ""),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	dump := &adminv3.RoutesConfigDump{
+		DynamicRouteConfigs: []*adminv3.RoutesConfigDump_DynamicRouteConfig{
+			{RouteConfig: routeConfig},
+		},
+	}
+
+	got, err := xRouteRuleMappings(dump, "HTTPRoute", types.NamespacedName{Namespace: "default", Name: "backend"})
+	require.NoError(t, err)
+	require.Equal(t, []xRouteRuleMapping{
+		{
+			Rule:        0,
+			RouteConfig: "default/eg/http",
+			VirtualHost: "default/eg/http/www_example_com",
+			Route:       "httproute/default/backend/rule/0/match/0/www_example_com",
+			Clusters:    []string{"httproute/default/backend/rule/0"},
+		},
+		{
+			Rule:        1,
+			SectionName: "canary",
+			RouteConfig: "default/eg/http",
+			VirtualHost: "default/eg/http/www_example_com",
+			Route:       "httproute/default/backend/rule/1/match/0/www_example_com",
+			Clusters:    []string{"httproute/default/backend/rule/1/backend/0", "httproute/default/backend/rule/1/backend/1"},
+		},
+	}, got)
+
+	got, err = xRouteRuleMappings(dump, "HTTPRoute", types.NamespacedName{Namespace: "default", Name: "missing"})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
 func TestLabelSelectorBadInput(t *testing.T) {
 	podNamespace = "default"
 
@@ -250,7 +449,7 @@ func TestLabelSelectorBadInput(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			labelSelectors = tc.labels
-			_, err := retrieveConfigDump(tc.args, false, AllEnvoyConfigType)
+			_, err := retrieveConfigDump(tc.args, false, AllEnvoyConfigType, nil)
 			require.Error(t, err, "error not found")
 		})
 	}
@@ -0,0 +1,213 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+const (
+	// The metadata written by the xDS translator on the routes to identify the
+	// Gateway API resources they have been built from.
+	envoyGatewayXdsMetadataNamespace = "envoy-gateway"
+	envoyGatewayMetadataKeyResources = "resources"
+)
+
+var ruleIndexRegex = regexp.MustCompile(`/rule/(\d+)/`)
+
+// xRouteRuleMapping describes the xDS route built from a rule of an xRoute.
+type xRouteRuleMapping struct {
+	// Rule is the index of the rule in the xRoute.
+	Rule int `json:"rule"`
+	// SectionName is the name of the rule, if any.
+	SectionName string `json:"sectionName,omitempty"`
+	// RouteConfig is the name of the xDS route configuration holding the route.
+	RouteConfig string `json:"routeConfig"`
+	// VirtualHost is the name of the xDS virtual host holding the route.
+	VirtualHost string `json:"virtualHost"`
+	// Route is the name of the xDS route.
+	Route string `json:"route"`
+	// Clusters are the names of the xDS clusters the route forwards the requests to.
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+func xRouteConfigCmd() *cobra.Command {
+	var kind, route string
+
+	configCmd := &cobra.Command{
+		Use:   "xroute <pod-name>",
+		Short: "Retrieves the xDS routes and clusters built from each rule of an xRoute",
+		Long: `Retrieves the xDS routes and clusters built from each rule of an HTTPRoute or a GRPCRoute from the Envoy instance in the specified pod.
+The xDS routes are correlated with the rules of the xRoute with the metadata written on them by Envoy Gateway.`,
+		Example: `  # Retrieve the xDS routes and clusters of the rules of an HTTPRoute for a given pod from Envoy.
+  egctl config envoy-proxy xroute <pod-name> -n <pod-namespace> --route default/backend
+
+  # Retrieve the xDS routes and clusters of the rules of a GRPCRoute as YAML
+  egctl config envoy-proxy xroute <pod-name> -n <pod-namespace> --kind GRPCRoute --route default/backend -o yaml
+
+  # Retrieve the xDS routes and clusters of the rules of an HTTPRoute for the pods matching label selectors
+  egctl config envoy-proxy xroute --labels gateway.envoyproxy.io/owning-gateway-name=eg -l gateway.envoyproxy.io/owning-gateway-namespace=default --route default/backend
+`,
+		Run: func(c *cobra.Command, args []string) {
+			cmdutil.CheckErr(runXRouteConfig(c, args, kind, route))
+		},
+	}
+
+	configCmd.Flags().StringVar(&kind, "kind", resource.KindHTTPRoute, "Kind of the xRoute, one of HTTPRoute or GRPCRoute.")
+	configCmd.Flags().StringVar(&route, "route", "", "Namespace and name of the xRoute, in the <namespace>/<name> format.")
+
+	return configCmd
+}
+
+func runXRouteConfig(c *cobra.Command, args []string, kind, route string) error {
+	if kind != resource.KindHTTPRoute && kind != resource.KindGRPCRoute {
+		return fmt.Errorf("unsupported xRoute kind %q, must be one of %s or %s", kind, resource.KindHTTPRoute, resource.KindGRPCRoute)
+	}
+	namespace, name, found := strings.Cut(route, "/")
+	if !found || namespace == "" || name == "" {
+		return fmt.Errorf("--route must be set in the <namespace>/<name> format, got %q", route)
+	}
+	routeNN := types.NamespacedName{Namespace: namespace, Name: name}
+
+	configDump, err := retrieveConfigDump(args, false, RouteEnvoyConfigType, nil)
+	if err != nil {
+		return err
+	}
+
+	mappings := make(map[string]map[string][]xRouteRuleMapping)
+	for ns, nsConfigs := range configDump {
+		mappings[ns] = make(map[string][]xRouteRuleMapping)
+		for pod, podConfigs := range nsConfigs {
+			cfg, ok := podConfigs.(*anypb.Any)
+			if !ok {
+				return fmt.Errorf("unexpected route config dump type %T", podConfigs)
+			}
+			routes := &adminv3.RoutesConfigDump{}
+			if err := cfg.UnmarshalTo(routes); err != nil {
+				return err
+			}
+			if mappings[ns][pod], err = xRouteRuleMappings(routes, kind, routeNN); err != nil {
+				return err
+			}
+		}
+	}
+
+	out, err := json.MarshalIndent(mappings, "", "  ")
+	if err != nil {
+		return err
+	}
+	switch {
+	case output == "yaml":
+		out, err = yaml.JSONToYAML(out)
+	case isJSONPathOutput(output):
+		out, err = printJSONPath(out, output)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(c.OutOrStdout(), string(out))
+	return err
+}
+
+// xRouteRuleMappings returns the xDS routes of the config dump built from the rules of
+// the xRoute, in the order of the config dump.
+func xRouteRuleMappings(dump *adminv3.RoutesConfigDump, kind string, route types.NamespacedName) ([]xRouteRuleMapping, error) {
+	var routeConfigs []*anypb.Any
+	for _, rc := range dump.StaticRouteConfigs {
+		routeConfigs = append(routeConfigs, rc.RouteConfig)
+	}
+	for _, rc := range dump.DynamicRouteConfigs {
+		routeConfigs = append(routeConfigs, rc.RouteConfig)
+	}
+
+	mappings := []xRouteRuleMapping{}
+	for _, cfg := range routeConfigs {
+		if cfg == nil {
+			continue
+		}
+		rc := &routev3.RouteConfiguration{}
+		if err := cfg.UnmarshalTo(rc); err != nil {
+			return nil, err
+		}
+
+		for _, vh := range rc.VirtualHosts {
+			for _, r := range vh.Routes {
+				sectionName, ok := routeResourceMetadata(r, kind, route)
+				if !ok {
+					continue
+				}
+
+				rule := -1
+				if m := ruleIndexRegex.FindStringSubmatch(r.Name); m != nil {
+					rule, _ = strconv.Atoi(m[1])
+				}
+				mappings = append(mappings, xRouteRuleMapping{
+					Rule:        rule,
+					SectionName: sectionName,
+					RouteConfig: rc.Name,
+					VirtualHost: vh.Name,
+					Route:       r.Name,
+					Clusters:    routeClusters(r),
+				})
+			}
+		}
+	}
+
+	return mappings, nil
+}
+
+// routeResourceMetadata returns the section name of the resource of the Envoy Gateway
+// metadata of the route matching the xRoute, and whether there is one.
+func routeResourceMetadata(r *routev3.Route, kind string, route types.NamespacedName) (string, bool) {
+	metadata := r.GetMetadata().GetFilterMetadata()[envoyGatewayXdsMetadataNamespace]
+	resources := metadata.GetFields()[envoyGatewayMetadataKeyResources].GetListValue()
+	for _, v := range resources.GetValues() {
+		fields := v.GetStructValue().GetFields()
+		if stringValue(fields, "kind") == kind &&
+			stringValue(fields, "namespace") == route.Namespace &&
+			stringValue(fields, "name") == route.Name {
+			return stringValue(fields, "sectionName"), true
+		}
+	}
+	return "", false
+}
+
+func stringValue(fields map[string]*structpb.Value, key string) string {
+	return fields[key].GetStringValue()
+}
+
+// routeClusters returns the names of the clusters the route forwards the requests to.
+func routeClusters(r *routev3.Route) []string {
+	action := r.GetRoute()
+	if action == nil {
+		return nil
+	}
+	if cluster := action.GetCluster(); cluster != "" {
+		return []string{cluster}
+	}
+
+	var clusters []string
+	for _, wc := range action.GetWeightedClusters().GetClusters() {
+		clusters = append(clusters, wc.GetName())
+	}
+	return clusters
+}
@@ -0,0 +1,546 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	kube "github.com/envoyproxy/gateway/internal/kubernetes"
+)
+
+func newGatewayDashboardCmd() *cobra.Command {
+	var (
+		namespace, proxyNamespace string
+		listenPort                int
+	)
+
+	dashboardCmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Serve a local dashboard of the live state of the Gateways",
+		Long: `Serve a local web UI showing the Gateways with their listeners, the routes and policies attached to them,
+and the xDS versions acknowledged or rejected by their Envoy proxies. The state is collected again on every page load.`,
+		Example: `  # Serve the dashboard of the Gateways in all namespaces.
+  egctl experimental dashboard gateway -A
+
+  # Serve the dashboard of the Gateways in a namespace on a specific local port.
+  egctl experimental dashboard gateway -n default -p 8080
+`,
+		Aliases: []string{"gtw"},
+		Args:    cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if listenPort > 65535 || listenPort < 0 {
+				return fmt.Errorf("invalid port number range")
+			}
+			if allNamespaces {
+				namespace = ""
+			}
+
+			k8sClient, err := newK8sClient()
+			if err != nil {
+				return err
+			}
+			kubeClient, err := getCLIClient()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt)
+			defer stop()
+
+			return serveGatewayDashboard(ctx, c, listenPort, func(ctx context.Context) (*gatewayDashboard, error) {
+				d, err := collectGatewayDashboard(ctx, k8sClient, namespace)
+				if err != nil {
+					return nil, err
+				}
+				d.collectProxySyncState(kubeClient, proxyNamespace)
+				return d, nil
+			})
+		},
+	}
+	dashboardCmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "default", "Namespace of the Gateways.")
+	dashboardCmd.PersistentFlags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show the Gateways in all namespaces.")
+	dashboardCmd.PersistentFlags().StringVar(&proxyNamespace, "proxy-namespace", "envoy-gateway-system", "Namespace where envoy proxy pods are installed.")
+	dashboardCmd.PersistentFlags().IntVarP(&listenPort, "port", "p", 0, "Local port to listen to.")
+
+	return dashboardCmd
+}
+
+// serveGatewayDashboard serves the dashboard on localhost until the context is done.
+func serveGatewayDashboard(ctx context.Context, c *cobra.Command, listenPort int, collect func(context.Context) (*gatewayDashboard, error)) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		d, err := collect(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := gatewayDashboardTemplate.Execute(w, d); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	mux.HandleFunc("/api/gateways", func(w http.ResponseWriter, r *http.Request) {
+		d, err := collect(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(d)
+	})
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", listenPort))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", listenPort, err)
+	}
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	openBrowser(fmt.Sprintf("http://%s", listener.Addr()), c.OutOrStdout())
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// gatewayDashboard is the state of the Gateways shown by the dashboard.
+type gatewayDashboard struct {
+	GeneratedAt time.Time          `json:"generatedAt"`
+	Gateways    []dashboardGateway `json:"gateways"`
+}
+
+type dashboardGateway struct {
+	Namespace  string               `json:"namespace"`
+	Name       string               `json:"name"`
+	Class      string               `json:"class"`
+	Conditions []metav1.Condition   `json:"conditions,omitempty"`
+	Listeners  []dashboardListener  `json:"listeners,omitempty"`
+	Routes     []dashboardAttached  `json:"routes,omitempty"`
+	Policies   []dashboardAttached  `json:"policies,omitempty"`
+	Proxies    []dashboardProxySync `json:"proxies,omitempty"`
+}
+
+type dashboardListener struct {
+	Name           string             `json:"name"`
+	Protocol       string             `json:"protocol"`
+	Port           int32              `json:"port"`
+	Hostname       string             `json:"hostname,omitempty"`
+	AttachedRoutes int32              `json:"attachedRoutes"`
+	Conditions     []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// dashboardAttached is a route or a policy attached to a Gateway, with the conditions set for that Gateway.
+type dashboardAttached struct {
+	Kind       string             `json:"kind"`
+	Namespace  string             `json:"namespace"`
+	Name       string             `json:"name"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// dashboardProxySync is the xDS sync state of an Envoy proxy of a Gateway.
+type dashboardProxySync struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Error     string         `json:"error,omitempty"`
+	XDS       []xdsSyncState `json:"xds,omitempty"`
+}
+
+// xdsSyncState is the latest version of an xDS type acknowledged by an Envoy proxy, and the resources it rejected.
+type xdsSyncState struct {
+	Type      string   `json:"type"`
+	Version   string   `json:"version"`
+	Resources int      `json:"resources"`
+	Rejected  []string `json:"rejected,omitempty"`
+}
+
+// collectGatewayDashboard collects the Gateways of the namespace, and the routes and policies attached to them.
+func collectGatewayDashboard(ctx context.Context, cli client.Client, namespace string) (*gatewayDashboard, error) {
+	gateways := gwapiv1.GatewayList{}
+	if err := cli.List(ctx, &gateways, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	d := &gatewayDashboard{GeneratedAt: time.Now()}
+	index := map[types.NamespacedName]*dashboardGateway{}
+	for i := range gateways.Items {
+		gtw := &gateways.Items[i]
+		g := dashboardGateway{
+			Namespace:  gtw.Namespace,
+			Name:       gtw.Name,
+			Class:      string(gtw.Spec.GatewayClassName),
+			Conditions: gtw.Status.Conditions,
+		}
+		for _, l := range gtw.Spec.Listeners {
+			listener := dashboardListener{
+				Name:     string(l.Name),
+				Protocol: string(l.Protocol),
+				Port:     int32(l.Port),
+			}
+			if l.Hostname != nil {
+				listener.Hostname = string(*l.Hostname)
+			}
+			for _, ls := range gtw.Status.Listeners {
+				if ls.Name == l.Name {
+					listener.AttachedRoutes = ls.AttachedRoutes
+					listener.Conditions = ls.Conditions
+				}
+			}
+			g.Listeners = append(g.Listeners, listener)
+		}
+		d.Gateways = append(d.Gateways, g)
+	}
+	for i := range d.Gateways {
+		index[types.NamespacedName{Namespace: d.Gateways[i].Namespace, Name: d.Gateways[i].Name}] = &d.Gateways[i]
+	}
+
+	// Routes and policies may be attached to Gateways of other namespaces, so they are listed in all namespaces.
+	if err := collectAttachedRoutes(ctx, cli, index); err != nil {
+		return nil, err
+	}
+	if err := collectAttachedPolicies(ctx, cli, index); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func collectAttachedRoutes(ctx context.Context, cli client.Client, index map[types.NamespacedName]*dashboardGateway) error {
+	attach := func(kind string, route metav1.Object, parents []gwapiv1.RouteParentStatus) {
+		for _, parent := range parents {
+			if parent.ParentRef.Kind != nil && string(*parent.ParentRef.Kind) != resource.KindGateway {
+				continue
+			}
+			g, ok := index[parentRefNamespacedName(parent.ParentRef, route.GetNamespace())]
+			if !ok {
+				continue
+			}
+			g.Routes = append(g.Routes, dashboardAttached{
+				Kind:       kind,
+				Namespace:  route.GetNamespace(),
+				Name:       route.GetName(),
+				Conditions: parent.Conditions,
+			})
+		}
+	}
+
+	httpRoutes := gwapiv1.HTTPRouteList{}
+	if err := cli.List(ctx, &httpRoutes); err != nil {
+		return err
+	}
+	for i := range httpRoutes.Items {
+		attach(resource.KindHTTPRoute, &httpRoutes.Items[i], httpRoutes.Items[i].Status.Parents)
+	}
+
+	grpcRoutes := gwapiv1.GRPCRouteList{}
+	if err := cli.List(ctx, &grpcRoutes); err != nil {
+		return err
+	}
+	for i := range grpcRoutes.Items {
+		attach(resource.KindGRPCRoute, &grpcRoutes.Items[i], grpcRoutes.Items[i].Status.Parents)
+	}
+
+	tlsRoutes := gwapiv1a2.TLSRouteList{}
+	if err := cli.List(ctx, &tlsRoutes); err != nil {
+		return err
+	}
+	for i := range tlsRoutes.Items {
+		attach(resource.KindTLSRoute, &tlsRoutes.Items[i], tlsRoutes.Items[i].Status.Parents)
+	}
+
+	tcpRoutes := gwapiv1a2.TCPRouteList{}
+	if err := cli.List(ctx, &tcpRoutes); err != nil {
+		return err
+	}
+	for i := range tcpRoutes.Items {
+		attach(resource.KindTCPRoute, &tcpRoutes.Items[i], tcpRoutes.Items[i].Status.Parents)
+	}
+
+	udpRoutes := gwapiv1a2.UDPRouteList{}
+	if err := cli.List(ctx, &udpRoutes); err != nil {
+		return err
+	}
+	for i := range udpRoutes.Items {
+		attach(resource.KindUDPRoute, &udpRoutes.Items[i], udpRoutes.Items[i].Status.Parents)
+	}
+
+	return nil
+}
+
+func collectAttachedPolicies(ctx context.Context, cli client.Client, index map[types.NamespacedName]*dashboardGateway) error {
+	// Policies targeting routes have the Gateways of the routes as ancestors.
+	attach := func(kind string, policy metav1.Object, ancestors []gwapiv1a2.PolicyAncestorStatus) {
+		for _, ancestor := range ancestors {
+			if ancestor.AncestorRef.Kind != nil && string(*ancestor.AncestorRef.Kind) != resource.KindGateway {
+				continue
+			}
+			g, ok := index[parentRefNamespacedName(ancestor.AncestorRef, policy.GetNamespace())]
+			if !ok {
+				continue
+			}
+			g.Policies = append(g.Policies, dashboardAttached{
+				Kind:       kind,
+				Namespace:  policy.GetNamespace(),
+				Name:       policy.GetName(),
+				Conditions: ancestor.Conditions,
+			})
+		}
+	}
+
+	ctps := egv1a1.ClientTrafficPolicyList{}
+	if err := cli.List(ctx, &ctps); err != nil {
+		return err
+	}
+	for i := range ctps.Items {
+		attach(resource.KindClientTrafficPolicy, &ctps.Items[i], ctps.Items[i].Status.Ancestors)
+	}
+
+	btps := egv1a1.BackendTrafficPolicyList{}
+	if err := cli.List(ctx, &btps); err != nil {
+		return err
+	}
+	for i := range btps.Items {
+		attach(resource.KindBackendTrafficPolicy, &btps.Items[i], btps.Items[i].Status.Ancestors)
+	}
+
+	sps := egv1a1.SecurityPolicyList{}
+	if err := cli.List(ctx, &sps); err != nil {
+		return err
+	}
+	for i := range sps.Items {
+		attach(resource.KindSecurityPolicy, &sps.Items[i], sps.Items[i].Status.Ancestors)
+	}
+
+	eeps := egv1a1.EnvoyExtensionPolicyList{}
+	if err := cli.List(ctx, &eeps); err != nil {
+		return err
+	}
+	for i := range eeps.Items {
+		attach(resource.KindEnvoyExtensionPolicy, &eeps.Items[i], eeps.Items[i].Status.Ancestors)
+	}
+
+	epps := egv1a1.EnvoyPatchPolicyList{}
+	if err := cli.List(ctx, &epps); err != nil {
+		return err
+	}
+	for i := range epps.Items {
+		attach(resource.KindEnvoyPatchPolicy, &epps.Items[i], epps.Items[i].Status.Ancestors)
+	}
+
+	return nil
+}
+
+func parentRefNamespacedName(ref gwapiv1.ParentReference, defaultNamespace string) types.NamespacedName {
+	nn := types.NamespacedName{Namespace: defaultNamespace, Name: string(ref.Name)}
+	if ref.Namespace != nil {
+		nn.Namespace = string(*ref.Namespace)
+	}
+	return nn
+}
+
+// collectProxySyncState collects the xDS sync state of the Envoy proxies of every Gateway.
+// Failures are reported in the dashboard rather than failing the whole page.
+func (d *gatewayDashboard) collectProxySyncState(cli kube.CLIClient, proxyNamespace string) {
+	var wg sync.WaitGroup
+	for i := range d.Gateways {
+		g := &d.Gateways[i]
+
+		var selectors []string
+		for k, v := range gatewayapi.GatewayOwnerLabels(g.Namespace, g.Name) {
+			selectors = append(selectors, k+"="+v)
+		}
+		pods, err := cli.PodsForSelector(proxyNamespace, selectors...)
+		if err != nil {
+			g.Proxies = []dashboardProxySync{{Namespace: proxyNamespace, Error: err.Error()}}
+			continue
+		}
+
+		g.Proxies = make([]dashboardProxySync, len(pods.Items))
+		for j := range pods.Items {
+			proxy := &g.Proxies[j]
+			proxy.Namespace, proxy.Name = pods.Items[j].Namespace, pods.Items[j].Name
+			if pods.Items[j].Status.Phase != "Running" {
+				proxy.Error = fmt.Sprintf("pod is %s", pods.Items[j].Status.Phase)
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				configDump, err := proxyConfigDump(cli, types.NamespacedName{Namespace: proxy.Namespace, Name: proxy.Name})
+				if err != nil {
+					proxy.Error = err.Error()
+					return
+				}
+				if proxy.XDS, err = xdsSyncStates(configDump); err != nil {
+					proxy.Error = err.Error()
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func proxyConfigDump(cli kube.CLIClient, pod types.NamespacedName) (*adminv3.ConfigDump, error) {
+	fw, err := portForwarder(cli, pod, adminPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize pod-forwarding for %s: %w", pod, err)
+	}
+	if err := fw.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start port forwarding for pod %s: %w", pod, err)
+	}
+	defer fw.Stop()
+
+	out, err := configDumpRequest(fw.Address(), false)
+	if err != nil {
+		return nil, err
+	}
+	configDump := &adminv3.ConfigDump{}
+	if err := protojson.Unmarshal(out, configDump); err != nil {
+		return nil, err
+	}
+	return configDump, nil
+}
+
+// xdsSyncStates returns the latest acknowledged version of each xDS type of the config dump,
+// along with the resources whose last update was rejected.
+func xdsSyncStates(configDump *adminv3.ConfigDump) ([]xdsSyncState, error) {
+	var states []xdsSyncState
+	for _, cfg := range configDump.Configs {
+		dump, err := cfg.UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+
+		var state xdsSyncState
+		var latest *timestamppb.Timestamp
+		track := func(name, version string, lastUpdated *timestamppb.Timestamp, errorState *adminv3.UpdateFailureState) {
+			state.Resources++
+			if version != "" && (latest == nil || lastUpdated.AsTime().After(latest.AsTime())) {
+				state.Version, latest = version, lastUpdated
+			}
+			if errorState != nil {
+				state.Rejected = append(state.Rejected, fmt.Sprintf("%s: %s", name, errorState.Details))
+			}
+		}
+
+		switch dump := dump.(type) {
+		case *adminv3.ListenersConfigDump:
+			state.Type = "Listener"
+			for _, l := range dump.DynamicListeners {
+				track(l.Name, l.GetActiveState().GetVersionInfo(), l.GetActiveState().GetLastUpdated(), l.ErrorState)
+			}
+			// The version of the last update of the whole type takes precedence when set.
+			if dump.VersionInfo != "" {
+				state.Version = dump.VersionInfo
+			}
+		case *adminv3.ClustersConfigDump:
+			state.Type = "Cluster"
+			for _, c := range dump.DynamicActiveClusters {
+				track(configEntryName(c.ProtoReflect()), c.VersionInfo, c.LastUpdated, c.ErrorState)
+			}
+			if dump.VersionInfo != "" {
+				state.Version = dump.VersionInfo
+			}
+		case *adminv3.RoutesConfigDump:
+			state.Type = "RouteConfiguration"
+			for _, r := range dump.DynamicRouteConfigs {
+				track(configEntryName(r.ProtoReflect()), r.VersionInfo, r.LastUpdated, r.ErrorState)
+			}
+		case *adminv3.SecretsConfigDump:
+			state.Type = "Secret"
+			for _, s := range dump.DynamicActiveSecrets {
+				track(s.Name, s.VersionInfo, s.LastUpdated, s.ErrorState)
+			}
+		default:
+			continue
+		}
+		states = append(states, state)
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].Type < states[j].Type })
+	return states, nil
+}
+
+var gatewayDashboardTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Envoy Gateway Dashboard</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+.True { color: green; }
+.False, .error { color: red; }
+.Unknown { color: orange; }
+</style>
+</head>
+<body>
+<h1>Envoy Gateway Dashboard</h1>
+<p>Generated at {{ .GeneratedAt.Format "2006-01-02 15:04:05" }}. Reload the page to refresh.</p>
+{{ define "conditions" }}{{ range . }}<span class="{{ .Status }}" title="{{ .Message }}">{{ .Type }}={{ .Status }} ({{ .Reason }})</span><br>{{ end }}{{ end }}
+{{ range .Gateways }}
+<h2>Gateway {{ .Namespace }}/{{ .Name }}</h2>
+<p>GatewayClass {{ .Class }}</p>
+<p>{{ template "conditions" .Conditions }}</p>
+<h3>Listeners</h3>
+<table>
+<tr><th>Name</th><th>Protocol</th><th>Port</th><th>Hostname</th><th>Attached Routes</th><th>Conditions</th></tr>
+{{ range .Listeners }}<tr><td>{{ .Name }}</td><td>{{ .Protocol }}</td><td>{{ .Port }}</td><td>{{ .Hostname }}</td><td>{{ .AttachedRoutes }}</td><td>{{ template "conditions" .Conditions }}</td></tr>
+{{ end }}</table>
+<h3>Routes</h3>
+<table>
+<tr><th>Kind</th><th>Namespace</th><th>Name</th><th>Conditions</th></tr>
+{{ range .Routes }}<tr><td>{{ .Kind }}</td><td>{{ .Namespace }}</td><td>{{ .Name }}</td><td>{{ template "conditions" .Conditions }}</td></tr>
+{{ end }}</table>
+<h3>Policies</h3>
+<table>
+<tr><th>Kind</th><th>Namespace</th><th>Name</th><th>Conditions</th></tr>
+{{ range .Policies }}<tr><td>{{ .Kind }}</td><td>{{ .Namespace }}</td><td>{{ .Name }}</td><td>{{ template "conditions" .Conditions }}</td></tr>
+{{ end }}</table>
+<h3>Proxies</h3>
+<table>
+<tr><th>Pod</th><th>xDS Type</th><th>Acknowledged Version</th><th>Resources</th><th>Rejected</th></tr>
+{{ range .Proxies }}{{ $pod := printf "%s/%s" .Namespace .Name }}{{ if .Error }}<tr><td>{{ $pod }}</td><td colspan="4" class="error">{{ .Error }}</td></tr>
+{{ end }}{{ range .XDS }}<tr><td>{{ $pod }}</td><td>{{ .Type }}</td><td>{{ .Version }}</td><td>{{ .Resources }}</td><td class="error">{{ range .Rejected }}{{ . }}<br>{{ end }}</td></tr>
+{{ end }}{{ end }}</table>
+{{ else }}
+<p>No Gateways found.</p>
+{{ end }}
+</body>
+</html>
+`))
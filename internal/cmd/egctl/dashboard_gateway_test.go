@@ -0,0 +1,117 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"testing"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+)
+
+func TestCollectGatewayDashboard(t *testing.T) {
+	accepted := []metav1.Condition{{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"}}
+	gateway := &gwapiv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "eg"},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: "eg",
+			Listeners: []gwapiv1.Listener{
+				{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80},
+			},
+		},
+		Status: gwapiv1.GatewayStatus{
+			Conditions: accepted,
+			Listeners: []gwapiv1.ListenerStatus{
+				{Name: "http", AttachedRoutes: 1, Conditions: accepted},
+			},
+		},
+	}
+	route := &gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "backend"},
+		Status: gwapiv1.HTTPRouteStatus{
+			RouteStatus: gwapiv1.RouteStatus{
+				Parents: []gwapiv1.RouteParentStatus{
+					{
+						ParentRef:  gwapiv1.ParentReference{Name: "eg", Namespace: ptr.To(gwapiv1.Namespace("default"))},
+						Conditions: accepted,
+					},
+					{
+						ParentRef: gwapiv1.ParentReference{Name: "other", Namespace: ptr.To(gwapiv1.Namespace("default"))},
+					},
+				},
+			},
+		},
+	}
+	policy := &egv1a1.ClientTrafficPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ctp"},
+		Status: gwapiv1a2.PolicyStatus{
+			Ancestors: []gwapiv1a2.PolicyAncestorStatus{
+				{
+					AncestorRef: gwapiv1.ParentReference{Name: "eg"},
+					Conditions:  accepted,
+				},
+			},
+		},
+	}
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(gateway, route, policy).
+		Build()
+
+	d, err := collectGatewayDashboard(context.Background(), cli, "default")
+	require.NoError(t, err)
+	require.Len(t, d.Gateways, 1)
+
+	g := d.Gateways[0]
+	require.Equal(t, "eg", g.Class)
+	require.Equal(t, []dashboardListener{
+		{Name: "http", Protocol: "HTTP", Port: 80, AttachedRoutes: 1, Conditions: accepted},
+	}, g.Listeners)
+	require.Equal(t, []dashboardAttached{
+		{Kind: "HTTPRoute", Namespace: "app", Name: "backend", Conditions: accepted},
+	}, g.Routes)
+	require.Equal(t, []dashboardAttached{
+		{Kind: "ClientTrafficPolicy", Namespace: "default", Name: "ctp", Conditions: accepted},
+	}, g.Policies)
+}
+
+func TestXDSSyncStates(t *testing.T) {
+	listeners, err := anypb.New(&adminv3.ListenersConfigDump{
+		VersionInfo: "3",
+		DynamicListeners: []*adminv3.ListenersConfigDump_DynamicListener{
+			{Name: "default/eg/http"},
+			{
+				Name:       "default/eg/https",
+				ErrorState: &adminv3.UpdateFailureState{Details: "invalid certificate"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	secrets, err := anypb.New(&adminv3.SecretsConfigDump{
+		DynamicActiveSecrets: []*adminv3.SecretsConfigDump_DynamicSecret{
+			{Name: "default/cert", VersionInfo: "2"},
+		},
+	})
+	require.NoError(t, err)
+
+	states, err := xdsSyncStates(&adminv3.ConfigDump{Configs: []*anypb.Any{secrets, listeners}})
+	require.NoError(t, err)
+	require.Equal(t, []xdsSyncState{
+		{Type: "Listener", Version: "3", Resources: 2, Rejected: []string{"default/eg/https: invalid certificate"}},
+		{Type: "Secret", Version: "2", Resources: 1},
+	}, states)
+}
@@ -30,6 +30,11 @@ func newExperimentalCommand() *cobra.Command {
 	experimentalCommand.AddCommand(newUnInstallCommand())
 	experimentalCommand.AddCommand(newCollectCommand())
 	experimentalCommand.AddCommand(newValidateCommand())
+	experimentalCommand.AddCommand(newAnalyzeCommand())
+	experimentalCommand.AddCommand(newMigrateCommand())
+	experimentalCommand.AddCommand(newProbeCommand())
+	experimentalCommand.AddCommand(newTopCommand())
+	experimentalCommand.AddCommand(newCertsCommand())
 
 	return experimentalCommand
 }
@@ -0,0 +1,622 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	egresource "github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+const (
+	nginxAnnotationPrefix = "nginx.ingress.kubernetes.io/"
+
+	nginxRewriteTarget       = nginxAnnotationPrefix + "rewrite-target"
+	nginxSSLRedirect         = nginxAnnotationPrefix + "ssl-redirect"
+	nginxForceSSLRedirect    = nginxAnnotationPrefix + "force-ssl-redirect"
+	nginxProxyBodySize       = nginxAnnotationPrefix + "proxy-body-size"
+	nginxProxyConnectTimeout = nginxAnnotationPrefix + "proxy-connect-timeout"
+	nginxProxyReadTimeout    = nginxAnnotationPrefix + "proxy-read-timeout"
+
+	httpListenerName = "http"
+)
+
+func newMigrateCommand() *cobra.Command {
+	migrateCommand := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate resources of other implementations to Gateway API and Envoy Gateway resources",
+		Example: `  # Convert Ingress resources to Gateway API and Envoy Gateway resources.
+  egctl x migrate ingress -f <input file>
+	`,
+	}
+
+	migrateCommand.AddCommand(newMigrateIngressCommand())
+
+	return migrateCommand
+}
+
+func newMigrateIngressCommand() *cobra.Command {
+	var (
+		inFile                                          string
+		gatewayName, gatewayNamespace, gatewayClassName string
+	)
+
+	ingressCommand := &cobra.Command{
+		Use:   "ingress",
+		Short: "Convert Ingress resources to Gateway API and Envoy Gateway resources",
+		Long: `Convert Ingress resources, and the most common ingress-nginx annotations, to a Gateway, HTTPRoutes
+and Envoy Gateway policies. Annotations that cannot be converted are reported on stderr.`,
+		Example: `  # Convert the Ingress resources of a file, printing the result to stdout.
+  egctl x migrate ingress -f <input file>
+
+  # Convert the Ingress resources to routes attached to an existing Gateway.
+  egctl x migrate ingress -f <input file> --gateway eg --gateway-namespace envoy-gateway-system
+	`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrateIngress(cmd.OutOrStdout(), cmd.ErrOrStderr(), inFile, gatewayName, gatewayNamespace, gatewayClassName)
+		},
+	}
+
+	ingressCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file.")
+	if err := ingressCommand.MarkPersistentFlagRequired("file"); err != nil {
+		return nil
+	}
+	ingressCommand.PersistentFlags().StringVar(&gatewayName, "gateway", "eg", "Name of the Gateway the routes are attached to.")
+	ingressCommand.PersistentFlags().StringVar(&gatewayNamespace, "gateway-namespace", "default", "Namespace of the Gateway the routes are attached to.")
+	ingressCommand.PersistentFlags().StringVar(&gatewayClassName, "gateway-class", "eg", "Name of the GatewayClass of the Gateway.")
+
+	return ingressCommand
+}
+
+func runMigrateIngress(w, warnW io.Writer, inFile, gatewayName, gatewayNamespace, gatewayClassName string) error {
+	inBytes, err := getInputBytes(inFile)
+	if err != nil {
+		return fmt.Errorf("unable to read input file: %w", err)
+	}
+
+	var ingresses []*networkingv1.Ingress
+	if err := egresource.IterYAMLBytes(inBytes, func(yamlByte []byte) error {
+		var meta metav1.TypeMeta
+		if err := yaml.Unmarshal(yamlByte, &meta); err != nil {
+			return err
+		}
+		if meta.Kind != "Ingress" || meta.APIVersion != networkingv1.SchemeGroupVersion.String() {
+			return nil
+		}
+
+		ingress := &networkingv1.Ingress{}
+		if err := yaml.Unmarshal(yamlByte, ingress); err != nil {
+			return err
+		}
+		if ingress.Namespace == "" {
+			ingress.Namespace = "default"
+		}
+		ingresses = append(ingresses, ingress)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+
+	if len(ingresses) == 0 {
+		return fmt.Errorf("no %s Ingress found in the input", networkingv1.SchemeGroupVersion)
+	}
+
+	c := newIngressConverter(gatewayName, gatewayNamespace, gatewayClassName)
+	for _, ingress := range ingresses {
+		c.convert(ingress)
+	}
+
+	for _, warning := range c.warnings {
+		if _, err := fmt.Fprintf(warnW, "WARNING: %s\n", warning); err != nil {
+			return err
+		}
+	}
+
+	for i, obj := range c.objects() {
+		out, err := marshalMigratedObject(obj)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := fmt.Fprintln(w, "---"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// marshalMigratedObject marshals the object to YAML, without the empty status and creation timestamp.
+func marshalMigratedObject(obj runtime.Object) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+	return yaml.Marshal(content)
+}
+
+// ingressConverter converts Ingress resources to routes attached to a single Gateway.
+type ingressConverter struct {
+	gateway                *gwapiv1.Gateway
+	routes                 []*gwapiv1.HTTPRoute
+	referenceGrants        map[string]*gwapiv1b1.ReferenceGrant
+	backendTrafficPolicies []*egv1a1.BackendTrafficPolicy
+	clientTrafficPolicy    *egv1a1.ClientTrafficPolicy
+	warnings               []string
+}
+
+func newIngressConverter(gatewayName, gatewayNamespace, gatewayClassName string) *ingressConverter {
+	return &ingressConverter{
+		gateway: &gwapiv1.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gwapiv1.GroupVersion.String(),
+				Kind:       egresource.KindGateway,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      gatewayName,
+				Namespace: gatewayNamespace,
+			},
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: gwapiv1.ObjectName(gatewayClassName),
+				Listeners: []gwapiv1.Listener{
+					{
+						Name:     httpListenerName,
+						Protocol: gwapiv1.HTTPProtocolType,
+						Port:     80,
+						AllowedRoutes: &gwapiv1.AllowedRoutes{
+							Namespaces: &gwapiv1.RouteNamespaces{
+								From: ptr.To(gwapiv1.NamespacesFromAll),
+							},
+						},
+					},
+				},
+			},
+		},
+		referenceGrants: map[string]*gwapiv1b1.ReferenceGrant{},
+	}
+}
+
+// objects returns all the converted resources in a stable order.
+func (c *ingressConverter) objects() []runtime.Object {
+	objs := []runtime.Object{c.gateway}
+	for _, route := range c.routes {
+		objs = append(objs, route)
+	}
+
+	namespaces := make([]string, 0, len(c.referenceGrants))
+	for ns := range c.referenceGrants {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		objs = append(objs, c.referenceGrants[ns])
+	}
+
+	for _, policy := range c.backendTrafficPolicies {
+		objs = append(objs, policy)
+	}
+	if c.clientTrafficPolicy != nil {
+		objs = append(objs, c.clientTrafficPolicy)
+	}
+	return objs
+}
+
+func (c *ingressConverter) warnf(ingress *networkingv1.Ingress, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf("Ingress %s/%s: %s", ingress.Namespace, ingress.Name, fmt.Sprintf(format, args...)))
+}
+
+func (c *ingressConverter) convert(ingress *networkingv1.Ingress) {
+	annotations := ingress.Annotations
+
+	// ingress-nginx redirects HTTP to HTTPS by default for the hosts with TLS.
+	tlsHosts := map[string]string{}
+	for _, tls := range ingress.Spec.TLS {
+		if len(tls.Hosts) == 0 {
+			c.warnf(ingress, "TLS secret %s without hosts is not supported and was not converted", tls.SecretName)
+			continue
+		}
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = c.addHTTPSListener(ingress, host, tls.SecretName)
+		}
+	}
+	sslRedirect := len(tlsHosts) > 0 && annotations[nginxSSLRedirect] != "false"
+	if annotations[nginxForceSSLRedirect] == "true" {
+		sslRedirect = true
+	}
+
+	rewriteTarget, hasRewriteTarget := annotations[nginxRewriteTarget]
+	if hasRewriteTarget && strings.Contains(rewriteTarget, "$") {
+		c.warnf(ingress, "%s with capture groups is not supported and was not converted", nginxRewriteTarget)
+		hasRewriteTarget = false
+	}
+
+	var routes []*gwapiv1.HTTPRoute
+	for i, rule := range ingress.Spec.Rules {
+		name := ingress.Name
+		if len(ingress.Spec.Rules) > 1 {
+			name = fmt.Sprintf("%s-%d", ingress.Name, i)
+		}
+
+		route := c.newHTTPRoute(ingress, name)
+		if rule.Host != "" {
+			route.Spec.Hostnames = []gwapiv1.Hostname{gwapiv1.Hostname(rule.Host)}
+		}
+
+		// The HTTP requests are only redirected to HTTPS for the hosts with a listener terminating TLS.
+		listener, hasTLS := tlsHosts[rule.Host]
+		if hasTLS {
+			route.Spec.ParentRefs = append(route.Spec.ParentRefs, c.parentRef(listener))
+		}
+		switch {
+		case sslRedirect && hasTLS:
+			c.routes = append(c.routes, c.newRedirectRoute(ingress, name, route.Spec.Hostnames))
+		case annotations[nginxForceSSLRedirect] == "true":
+			c.warnf(ingress, "host %q has no TLS configuration, %s was not converted for it", rule.Host, nginxForceSSLRedirect)
+			fallthrough
+		default:
+			route.Spec.ParentRefs = append(route.Spec.ParentRefs, c.parentRef(httpListenerName))
+		}
+
+		if rule.HTTP != nil {
+			for _, path := range rule.HTTP.Paths {
+				routeRule, ok := c.convertPath(ingress, path, rewriteTarget, hasRewriteTarget)
+				if ok {
+					route.Spec.Rules = append(route.Spec.Rules, routeRule)
+				}
+			}
+		}
+		routes = append(routes, route)
+	}
+
+	if ingress.Spec.DefaultBackend != nil {
+		route := c.newHTTPRoute(ingress, ingress.Name+"-default-backend")
+		route.Spec.ParentRefs = []gwapiv1.ParentReference{c.parentRef(httpListenerName)}
+		if backendRef, ok := c.convertBackend(ingress, *ingress.Spec.DefaultBackend); ok {
+			route.Spec.Rules = []gwapiv1.HTTPRouteRule{{BackendRefs: []gwapiv1.HTTPBackendRef{backendRef}}}
+		}
+		routes = append(routes, route)
+	}
+
+	c.routes = append(c.routes, routes...)
+	c.convertTimeouts(ingress, routes)
+	c.convertBodySize(ingress)
+
+	var unsupported []string
+	for annotation := range annotations {
+		switch annotation {
+		case nginxRewriteTarget, nginxSSLRedirect, nginxForceSSLRedirect, nginxProxyBodySize,
+			nginxProxyConnectTimeout, nginxProxyReadTimeout:
+		default:
+			if strings.HasPrefix(annotation, nginxAnnotationPrefix) {
+				unsupported = append(unsupported, annotation)
+			}
+		}
+	}
+	sort.Strings(unsupported)
+	for _, annotation := range unsupported {
+		c.warnf(ingress, "annotation %s is not supported and was not converted", annotation)
+	}
+}
+
+// addHTTPSListener adds a listener terminating TLS for the host, and returns its name.
+func (c *ingressConverter) addHTTPSListener(ingress *networkingv1.Ingress, host, secretName string) string {
+	name := "https-" + strings.NewReplacer("*", "wildcard", ".", "-").Replace(host)
+	certificateRef := gwapiv1.SecretObjectReference{
+		Group: ptr.To(gwapiv1.Group("")),
+		Kind:  ptr.To(gwapiv1.Kind("Secret")),
+		Name:  gwapiv1.ObjectName(secretName),
+	}
+	if ingress.Namespace != c.gateway.Namespace {
+		certificateRef.Namespace = ptr.To(gwapiv1.Namespace(ingress.Namespace))
+		c.addReferenceGrant(ingress.Namespace)
+	}
+
+	for _, listener := range c.gateway.Spec.Listeners {
+		if string(listener.Name) != name {
+			continue
+		}
+		if listener.TLS.CertificateRefs[0] != certificateRef {
+			c.warnf(ingress, "host %s is already served with another certificate, secret %s was not converted", host, secretName)
+		}
+		return name
+	}
+
+	c.gateway.Spec.Listeners = append(c.gateway.Spec.Listeners, gwapiv1.Listener{
+		Name:     gwapiv1.SectionName(name),
+		Hostname: ptr.To(gwapiv1.Hostname(host)),
+		Protocol: gwapiv1.HTTPSProtocolType,
+		Port:     443,
+		TLS: &gwapiv1.GatewayTLSConfig{
+			Mode:            ptr.To(gwapiv1.TLSModeTerminate),
+			CertificateRefs: []gwapiv1.SecretObjectReference{certificateRef},
+		},
+		AllowedRoutes: &gwapiv1.AllowedRoutes{
+			Namespaces: &gwapiv1.RouteNamespaces{
+				From: ptr.To(gwapiv1.NamespacesFromAll),
+			},
+		},
+	})
+	return name
+}
+
+// addReferenceGrant allows the Gateway to reference the TLS secrets of the namespace.
+func (c *ingressConverter) addReferenceGrant(namespace string) {
+	if _, ok := c.referenceGrants[namespace]; ok {
+		return
+	}
+	c.referenceGrants[namespace] = &gwapiv1b1.ReferenceGrant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gwapiv1b1.GroupVersion.String(),
+			Kind:       "ReferenceGrant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-secrets", c.gateway.Namespace, c.gateway.Name),
+			Namespace: namespace,
+		},
+		Spec: gwapiv1b1.ReferenceGrantSpec{
+			From: []gwapiv1b1.ReferenceGrantFrom{
+				{
+					Group:     gwapiv1.GroupName,
+					Kind:      egresource.KindGateway,
+					Namespace: gwapiv1.Namespace(c.gateway.Namespace),
+				},
+			},
+			To: []gwapiv1b1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  egresource.KindSecret,
+				},
+			},
+		},
+	}
+}
+
+func (c *ingressConverter) parentRef(sectionName string) gwapiv1.ParentReference {
+	return gwapiv1.ParentReference{
+		Name:        gwapiv1.ObjectName(c.gateway.Name),
+		Namespace:   ptr.To(gwapiv1.Namespace(c.gateway.Namespace)),
+		SectionName: ptr.To(gwapiv1.SectionName(sectionName)),
+	}
+}
+
+func (c *ingressConverter) newHTTPRoute(ingress *networkingv1.Ingress, name string) *gwapiv1.HTTPRoute {
+	return &gwapiv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gwapiv1.GroupVersion.String(),
+			Kind:       egresource.KindHTTPRoute,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ingress.Namespace,
+		},
+	}
+}
+
+// newRedirectRoute returns a route redirecting the HTTP requests of the hostnames to HTTPS.
+func (c *ingressConverter) newRedirectRoute(ingress *networkingv1.Ingress, name string, hostnames []gwapiv1.Hostname) *gwapiv1.HTTPRoute {
+	route := c.newHTTPRoute(ingress, name+"-ssl-redirect")
+	route.Spec.ParentRefs = []gwapiv1.ParentReference{c.parentRef(httpListenerName)}
+	route.Spec.Hostnames = hostnames
+	route.Spec.Rules = []gwapiv1.HTTPRouteRule{
+		{
+			Filters: []gwapiv1.HTTPRouteFilter{
+				{
+					Type: gwapiv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwapiv1.HTTPRequestRedirectFilter{
+						Scheme:     ptr.To("https"),
+						StatusCode: ptr.To(301),
+					},
+				},
+			},
+		},
+	}
+	return route
+}
+
+func (c *ingressConverter) convertPath(ingress *networkingv1.Ingress, path networkingv1.HTTPIngressPath,
+	rewriteTarget string, hasRewriteTarget bool,
+) (gwapiv1.HTTPRouteRule, bool) {
+	backendRef, ok := c.convertBackend(ingress, path.Backend)
+	if !ok {
+		return gwapiv1.HTTPRouteRule{}, false
+	}
+
+	value := path.Path
+	if value == "" {
+		value = "/"
+	}
+	matchType := gwapiv1.PathMatchPathPrefix
+	if path.PathType != nil && *path.PathType == networkingv1.PathTypeExact {
+		matchType = gwapiv1.PathMatchExact
+	}
+
+	rule := gwapiv1.HTTPRouteRule{
+		Matches: []gwapiv1.HTTPRouteMatch{
+			{
+				Path: &gwapiv1.HTTPPathMatch{
+					Type:  ptr.To(matchType),
+					Value: ptr.To(value),
+				},
+			},
+		},
+		BackendRefs: []gwapiv1.HTTPBackendRef{backendRef},
+	}
+
+	if hasRewriteTarget {
+		pathModifier := &gwapiv1.HTTPPathModifier{
+			Type:               gwapiv1.PrefixMatchHTTPPathModifier,
+			ReplacePrefixMatch: ptr.To(rewriteTarget),
+		}
+		if matchType == gwapiv1.PathMatchExact {
+			pathModifier = &gwapiv1.HTTPPathModifier{
+				Type:            gwapiv1.FullPathHTTPPathModifier,
+				ReplaceFullPath: ptr.To(rewriteTarget),
+			}
+		}
+		rule.Filters = []gwapiv1.HTTPRouteFilter{
+			{
+				Type: gwapiv1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gwapiv1.HTTPURLRewriteFilter{
+					Path: pathModifier,
+				},
+			},
+		}
+	}
+
+	return rule, true
+}
+
+func (c *ingressConverter) convertBackend(ingress *networkingv1.Ingress, backend networkingv1.IngressBackend) (gwapiv1.HTTPBackendRef, bool) {
+	if backend.Service == nil {
+		c.warnf(ingress, "resource backends are not supported and were not converted")
+		return gwapiv1.HTTPBackendRef{}, false
+	}
+	if backend.Service.Port.Name != "" {
+		c.warnf(ingress, "service %s is referenced by port name %s, which is not supported by HTTPRoute and was not converted",
+			backend.Service.Name, backend.Service.Port.Name)
+		return gwapiv1.HTTPBackendRef{}, false
+	}
+
+	return gwapiv1.HTTPBackendRef{
+		BackendRef: gwapiv1.BackendRef{
+			BackendObjectReference: gwapiv1.BackendObjectReference{
+				Name: gwapiv1.ObjectName(backend.Service.Name),
+				Port: ptr.To(gwapiv1.PortNumber(backend.Service.Port.Number)),
+			},
+		},
+	}, true
+}
+
+// convertTimeouts converts the proxy timeout annotations to a BackendTrafficPolicy targeting the routes of the Ingress.
+func (c *ingressConverter) convertTimeouts(ingress *networkingv1.Ingress, routes []*gwapiv1.HTTPRoute) {
+	connectTimeout := c.parseNginxSeconds(ingress, nginxProxyConnectTimeout)
+	readTimeout := c.parseNginxSeconds(ingress, nginxProxyReadTimeout)
+	if (connectTimeout == nil && readTimeout == nil) || len(routes) == 0 {
+		return
+	}
+
+	policy := &egv1a1.BackendTrafficPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: egv1a1.GroupVersion.String(),
+			Kind:       egv1a1.KindBackendTrafficPolicy,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ingress.Name,
+			Namespace: ingress.Namespace,
+		},
+		Spec: egv1a1.BackendTrafficPolicySpec{
+			ClusterSettings: egv1a1.ClusterSettings{
+				Timeout: &egv1a1.Timeout{},
+			},
+		},
+	}
+	for _, route := range routes {
+		policy.Spec.TargetRefs = append(policy.Spec.TargetRefs, gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+			LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+				Group: gwapiv1.GroupName,
+				Kind:  egresource.KindHTTPRoute,
+				Name:  gwapiv1.ObjectName(route.Name),
+			},
+		})
+	}
+	if connectTimeout != nil {
+		policy.Spec.Timeout.TCP = &egv1a1.TCPTimeout{ConnectTimeout: connectTimeout}
+	}
+	// proxy-read-timeout bounds the time between two successive reads of the response, not the
+	// whole response, so it is converted to the stream idle timeout rather than the request timeout.
+	if readTimeout != nil {
+		policy.Spec.Timeout.HTTP = &egv1a1.HTTPTimeout{StreamIdleTimeout: readTimeout}
+	}
+
+	c.backendTrafficPolicies = append(c.backendTrafficPolicies, policy)
+}
+
+func (c *ingressConverter) parseNginxSeconds(ingress *networkingv1.Ingress, annotation string) *gwapiv1.Duration {
+	value, ok := ingress.Annotations[annotation]
+	if !ok {
+		return nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		c.warnf(ingress, "invalid value %q for annotation %s, it was not converted", value, annotation)
+		return nil
+	}
+	return ptr.To(gwapiv1.Duration(fmt.Sprintf("%ds", seconds)))
+}
+
+// convertBodySize converts the proxy-body-size annotation to the buffer limit of a ClientTrafficPolicy
+// targeting the Gateway. The largest value is used when multiple Ingresses set it.
+func (c *ingressConverter) convertBodySize(ingress *networkingv1.Ingress) {
+	value, ok := ingress.Annotations[nginxProxyBodySize]
+	if !ok || value == "0" {
+		return
+	}
+
+	// ingress-nginx uses the nginx size units, which are binary multiples.
+	quantity, err := resource.ParseQuantity(strings.NewReplacer("k", "Ki", "K", "Ki", "m", "Mi", "M", "Mi", "g", "Gi", "G", "Gi").Replace(value))
+	if err != nil {
+		c.warnf(ingress, "invalid value %q for annotation %s, it was not converted", value, nginxProxyBodySize)
+		return
+	}
+	c.warnf(ingress, "annotation %s was converted to the buffer limit of the connections of Gateway %s/%s, which applies to all its routes",
+		nginxProxyBodySize, c.gateway.Namespace, c.gateway.Name)
+
+	if c.clientTrafficPolicy == nil {
+		c.clientTrafficPolicy = &egv1a1.ClientTrafficPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: egv1a1.GroupVersion.String(),
+				Kind:       egv1a1.KindClientTrafficPolicy,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      c.gateway.Name,
+				Namespace: c.gateway.Namespace,
+			},
+			Spec: egv1a1.ClientTrafficPolicySpec{
+				PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+					TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1.GroupName,
+								Kind:  egresource.KindGateway,
+								Name:  gwapiv1.ObjectName(c.gateway.Name),
+							},
+						},
+					},
+				},
+				Connection: &egv1a1.ClientConnection{},
+			},
+		}
+	}
+
+	connection := c.clientTrafficPolicy.Spec.Connection
+	if connection.BufferLimit == nil || connection.BufferLimit.Cmp(quantity) < 0 {
+		connection.BufferLimit = &quantity
+	}
+}
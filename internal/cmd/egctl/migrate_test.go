@@ -0,0 +1,64 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/utils/file"
+)
+
+func TestMigrateIngress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		warnings string
+	}{
+		{
+			name: "ingress",
+			warnings: `WARNING: Ingress app/backend: annotation nginx.ingress.kubernetes.io/proxy-body-size was converted to the buffer limit of the connections of Gateway default/eg, which applies to all its routes
+WARNING: Ingress app/backend: annotation nginx.ingress.kubernetes.io/enable-cors is not supported and was not converted
+WARNING: Ingress default/legacy: service legacy is referenced by port name http, which is not supported by HTTPRoute and was not converted
+`,
+		},
+		{
+			name: "ingress-with-ssl-redirect",
+			warnings: `WARNING: Ingress default/no-tls: host "plain.example.com" has no TLS configuration, nginx.ingress.kubernetes.io/force-ssl-redirect was not converted for it
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			warnings := &bytes.Buffer{}
+			root := newMigrateCommand()
+			root.SetOut(out)
+			root.SetErr(warnings)
+			root.SetArgs([]string{
+				"ingress",
+				"--file",
+				filepath.Join("testdata", "migrate", "in", tc.name+".yaml"),
+			})
+
+			require.NoError(t, root.ExecuteContext(context.Background()))
+
+			outFile := filepath.Join("testdata", "migrate", "out", tc.name+".yaml")
+			if *overrideTestData {
+				require.NoError(t, file.Write(out.String(), outFile))
+			}
+			want, err := os.ReadFile(outFile)
+			require.NoError(t, err)
+			require.Equal(t, string(want), out.String())
+			require.Equal(t, tc.warnings, warnings.String())
+		})
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+const (
+	probeProtocolHTTP      = "http"
+	probeProtocolGRPC      = "grpc"
+	probeProtocolWebSocket = "websocket"
+)
+
+type probeOptions struct {
+	namespace          string
+	kind               string
+	protocol           string
+	address            string
+	path               string
+	timeout            time.Duration
+	insecureSkipVerify bool
+}
+
+func newProbeCommand() *cobra.Command {
+	opts := &probeOptions{}
+
+	probeCommand := &cobra.Command{
+		Use:   "probe <route-name>",
+		Short: "Send a test request through a Gateway to a route",
+		Long: `Send a test request through the Gateway a route is attached to, with the Host header and SNI derived from the
+hostnames of the route, to verify that the request matches the route and reaches a backend.
+The path of the request is derived from the first match of the route, and the address from the status of the Gateway.`,
+		Example: `  # Send an HTTP request to an HTTPRoute.
+  egctl experimental probe backend -n default
+
+  # Send a WebSocket upgrade request to an HTTPRoute with a specific path.
+  egctl experimental probe backend -n default --protocol websocket --path /ws
+
+  # Send a gRPC request to a GRPCRoute through a port-forwarded Gateway.
+  egctl experimental probe backend -n default --kind GRPCRoute --address localhost:8080
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			return runProbe(c.Context(), c.OutOrStdout(), args[0], opts)
+		},
+	}
+
+	flags := probeCommand.Flags()
+	flags.StringVarP(&opts.namespace, "namespace", "n", "default", "Namespace of the route.")
+	flags.StringVar(&opts.kind, "kind", resource.KindHTTPRoute, "Kind of the route, one of HTTPRoute or GRPCRoute.")
+	flags.StringVar(&opts.protocol, "protocol", "", "Protocol of the request, one of http, grpc or websocket. Defaults to grpc for a GRPCRoute and http otherwise.")
+	flags.StringVar(&opts.address, "address", "", "Address of the Gateway to send the request to, as host or host:port. Defaults to the first address in the Gateway status.")
+	flags.StringVar(&opts.path, "path", "", "Path of the request, or the full method like /pkg.Service/Method for gRPC. Defaults to the path of the first match of the route.")
+	flags.DurationVar(&opts.timeout, "timeout", 5*time.Second, "Timeout of the request.")
+	flags.BoolVar(&opts.insecureSkipVerify, "insecure-skip-verify", false, "Skip the verification of the certificate of the Gateway for HTTPS listeners.")
+
+	return probeCommand
+}
+
+// probeTarget is where and how a probe request is sent.
+type probeTarget struct {
+	Protocol string
+	// TLS is whether the listener of the Gateway terminates TLS.
+	TLS bool
+	// Address is the host:port of the Gateway.
+	Address string
+	// Host is used as the Host header and the SNI of the request.
+	Host string
+	Path string
+}
+
+// probeResult is the outcome of a probe request.
+type probeResult struct {
+	// Status is the HTTP status or the gRPC code of the response.
+	Status string
+	OK     bool
+	Reason string
+}
+
+func runProbe(ctx context.Context, w io.Writer, name string, opts *probeOptions) error {
+	k8sClient, err := newK8sClient()
+	if err != nil {
+		return err
+	}
+
+	target, err := resolveProbeTarget(ctx, k8sClient, types.NamespacedName{Namespace: opts.namespace, Name: name}, opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+	result, err := probe(ctx, target, opts.insecureSkipVerify)
+	if err != nil {
+		return fmt.Errorf("probe request failed: %w", err)
+	}
+
+	table := newStatusTableWriter(w)
+	writeStatusTable(table,
+		[]string{"PROTOCOL", "ADDRESS", "HOST", "PATH", "STATUS", "RESULT"},
+		[][]string{{target.Protocol, target.Address, target.Host, target.Path, result.Status, result.Reason}})
+	_ = table.Flush()
+
+	if !result.OK {
+		return errors.New("probe failed")
+	}
+	return nil
+}
+
+// resolveProbeTarget derives the target of the probe from the route and the Gateway it is attached to.
+func resolveProbeTarget(ctx context.Context, cli client.Client, nn types.NamespacedName, opts *probeOptions) (*probeTarget, error) {
+	var (
+		parentRefs []gwapiv1.ParentReference
+		hostnames  []gwapiv1.Hostname
+		path       string
+		protocol   = opts.protocol
+	)
+
+	switch opts.kind {
+	case resource.KindHTTPRoute:
+		route := &gwapiv1.HTTPRoute{}
+		if err := cli.Get(ctx, nn, route); err != nil {
+			return nil, fmt.Errorf("failed to get HTTPRoute %s: %w", nn, err)
+		}
+		parentRefs, hostnames = route.Spec.ParentRefs, route.Spec.Hostnames
+		path = httpRouteProbePath(route)
+		if protocol == "" {
+			protocol = probeProtocolHTTP
+		}
+	case resource.KindGRPCRoute:
+		route := &gwapiv1.GRPCRoute{}
+		if err := cli.Get(ctx, nn, route); err != nil {
+			return nil, fmt.Errorf("failed to get GRPCRoute %s: %w", nn, err)
+		}
+		parentRefs, hostnames = route.Spec.ParentRefs, route.Spec.Hostnames
+		path = grpcRouteProbePath(route)
+		if protocol == "" {
+			protocol = probeProtocolGRPC
+		}
+	default:
+		return nil, fmt.Errorf("unsupported route kind %s, must be one of %s or %s", opts.kind, resource.KindHTTPRoute, resource.KindGRPCRoute)
+	}
+
+	switch protocol {
+	case probeProtocolHTTP, probeProtocolGRPC, probeProtocolWebSocket:
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s, must be one of %s, %s or %s", protocol, probeProtocolHTTP, probeProtocolGRPC, probeProtocolWebSocket)
+	}
+	if opts.path != "" {
+		path = opts.path
+	}
+	if path == "" {
+		return nil, fmt.Errorf("unable to derive the path of the request from %s %s, use --path to set it", opts.kind, nn)
+	}
+
+	var parentRef *gwapiv1.ParentReference
+	for i := range parentRefs {
+		if parentRefs[i].Kind == nil || string(*parentRefs[i].Kind) == resource.KindGateway {
+			parentRef = &parentRefs[i]
+			break
+		}
+	}
+	if parentRef == nil {
+		return nil, fmt.Errorf("%s %s is not attached to any Gateway", opts.kind, nn)
+	}
+
+	gatewayNN := types.NamespacedName{Namespace: nn.Namespace, Name: string(parentRef.Name)}
+	if parentRef.Namespace != nil {
+		gatewayNN.Namespace = string(*parentRef.Namespace)
+	}
+	gateway := &gwapiv1.Gateway{}
+	if err := cli.Get(ctx, gatewayNN, gateway); err != nil {
+		return nil, fmt.Errorf("failed to get Gateway %s: %w", gatewayNN, err)
+	}
+
+	listener := probeListener(gateway, parentRef)
+	if listener == nil {
+		return nil, fmt.Errorf("no HTTP or HTTPS listener of Gateway %s matches the parentRef of %s %s", gatewayNN, opts.kind, nn)
+	}
+
+	host := probeHost(hostnames, listener.Hostname)
+	address := opts.address
+	switch {
+	case address == "":
+		if len(gateway.Status.Addresses) == 0 {
+			return nil, fmt.Errorf("no address in the status of Gateway %s, use --address to set it", gatewayNN)
+		}
+		address = net.JoinHostPort(gateway.Status.Addresses[0].Value, strconv.Itoa(int(listener.Port)))
+	case !hasPort(address):
+		address = net.JoinHostPort(address, strconv.Itoa(int(listener.Port)))
+	}
+	if host == "" {
+		host, _, _ = net.SplitHostPort(address)
+	}
+
+	return &probeTarget{
+		Protocol: protocol,
+		TLS:      listener.Protocol == gwapiv1.HTTPSProtocolType,
+		Address:  address,
+		Host:     host,
+		Path:     path,
+	}, nil
+}
+
+// httpRouteProbePath returns a path matched by the first rule of the route.
+func httpRouteProbePath(route *gwapiv1.HTTPRoute) string {
+	for _, rule := range route.Spec.Rules {
+		for _, match := range rule.Matches {
+			if match.Path == nil || match.Path.Value == nil {
+				return "/"
+			}
+			// A request path can't be derived from a regular expression.
+			if match.Path.Type != nil && *match.Path.Type == gwapiv1.PathMatchRegularExpression {
+				continue
+			}
+			return *match.Path.Value
+		}
+		return "/"
+	}
+	return "/"
+}
+
+// grpcRouteProbePath returns the full method matched by the first rule of the route.
+func grpcRouteProbePath(route *gwapiv1.GRPCRoute) string {
+	for _, rule := range route.Spec.Rules {
+		for _, match := range rule.Matches {
+			if match.Method == nil || match.Method.Service == nil || match.Method.Method == nil {
+				continue
+			}
+			if match.Method.Type != nil && *match.Method.Type == gwapiv1.GRPCMethodMatchRegularExpression {
+				continue
+			}
+			return fmt.Sprintf("/%s/%s", *match.Method.Service, *match.Method.Method)
+		}
+	}
+	return ""
+}
+
+// probeListener returns the HTTP or HTTPS listener of the Gateway selected by the parentRef.
+func probeListener(gateway *gwapiv1.Gateway, parentRef *gwapiv1.ParentReference) *gwapiv1.Listener {
+	for i := range gateway.Spec.Listeners {
+		listener := &gateway.Spec.Listeners[i]
+		if listener.Protocol != gwapiv1.HTTPProtocolType && listener.Protocol != gwapiv1.HTTPSProtocolType {
+			continue
+		}
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		return listener
+	}
+	return nil
+}
+
+// probeHost returns the host to send the request to, a wildcard is replaced by a label.
+func probeHost(routeHostnames []gwapiv1.Hostname, listenerHostname *gwapiv1.Hostname) string {
+	host := ""
+	switch {
+	case len(routeHostnames) > 0:
+		host = string(routeHostnames[0])
+	case listenerHostname != nil:
+		host = string(*listenerHostname)
+	}
+	if strings.HasPrefix(host, "*.") {
+		host = "probe" + host[1:]
+	}
+	return host
+}
+
+func hasPort(address string) bool {
+	_, _, err := net.SplitHostPort(address)
+	return err == nil
+}
+
+// probe sends the request to the target, and checks whether it matched the route and reached a backend.
+func probe(ctx context.Context, target *probeTarget, insecureSkipVerify bool) (*probeResult, error) {
+	// nolint:gosec
+	tlsConfig := &tls.Config{
+		ServerName:         target.Host,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if target.Protocol == probeProtocolGRPC {
+		creds := insecure.NewCredentials()
+		if target.TLS {
+			creds = credentials.NewTLS(tlsConfig)
+		}
+		return probeGRPC(ctx, target, creds)
+	}
+	return probeHTTP(ctx, target, tlsConfig)
+}
+
+func probeHTTP(ctx context.Context, target *probeTarget, tlsConfig *tls.Config) (*probeResult, error) {
+	scheme := "http"
+	if target.TLS {
+		scheme = "https"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s%s", scheme, target.Address, target.Path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Host = target.Host
+
+	if target.Protocol == probeProtocolWebSocket {
+		key := make([]byte, 16)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Sec-WebSocket-Version", "13")
+		req.Header.Set("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+	}
+
+	httpClient := &http.Client{
+		// The Transport is only used for a single request, and doesn't attempt HTTP/2,
+		// so that a WebSocket upgrade is possible over TLS.
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, DisableKeepAlives: true},
+		// A redirect is a response of the route, it's not followed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return httpProbeResult(target.Protocol, resp.StatusCode), nil
+}
+
+// httpProbeResult interprets the status of a response, based on the local replies of Envoy Gateway.
+func httpProbeResult(protocol string, code int) *probeResult {
+	result := &probeResult{Status: strconv.Itoa(code)}
+	switch {
+	case protocol == probeProtocolWebSocket && code == http.StatusSwitchingProtocols:
+		result.OK, result.Reason = true, "upgraded"
+	case protocol == probeProtocolWebSocket && code < http.StatusBadRequest:
+		result.Reason = "not upgraded, check that the backend supports WebSocket"
+	case code == http.StatusNotFound:
+		result.Reason = "not found, no route matched the request or the backend returned it"
+	case code == http.StatusInternalServerError:
+		result.Reason = "internal error, the route may have no valid backend, check the route status"
+	case code == http.StatusBadGateway, code == http.StatusServiceUnavailable, code == http.StatusGatewayTimeout:
+		result.Reason = "backend unreachable"
+	case code >= http.StatusInternalServerError:
+		result.Reason = http.StatusText(code)
+	default:
+		// Any other response means that the request matched the route and was handled by it.
+		result.OK, result.Reason = true, http.StatusText(code)
+	}
+	return result
+}
+
+func probeGRPC(ctx context.Context, target *probeTarget, creds credentials.TransportCredentials) (*probeResult, error) {
+	conn, err := grpc.NewClient(target.Address, grpc.WithTransportCredentials(creds), grpc.WithAuthority(target.Host))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	err = conn.Invoke(ctx, target.Path, &emptypb.Empty{}, &emptypb.Empty{})
+	return grpcProbeResult(status.Code(err)), nil
+}
+
+// grpcProbeResult interprets the code of a gRPC response, Envoy maps its local replies to
+// Unimplemented for a request not matching any route, and to Unavailable for an unreachable backend.
+func grpcProbeResult(code codes.Code) *probeResult {
+	result := &probeResult{Status: code.String()}
+	switch code {
+	case codes.Unimplemented:
+		result.Reason = "no route matched the request or the backend doesn't implement the method"
+	case codes.Unavailable:
+		result.Reason = "backend unreachable"
+	case codes.DeadlineExceeded:
+		result.Reason = "timed out"
+	default:
+		// An error from the backend, like an invalid argument, still means that the request reached it.
+		result.OK, result.Reason = true, "reached backend"
+	}
+	return result
+}
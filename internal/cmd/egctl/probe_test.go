@@ -0,0 +1,150 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+func TestResolveProbeTarget(t *testing.T) {
+	gateway := &gwapiv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "envoy-gateway", Name: "eg"},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: "eg",
+			Listeners: []gwapiv1.Listener{
+				{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80},
+				{Name: "https", Protocol: gwapiv1.HTTPSProtocolType, Port: 443, Hostname: ptr.To(gwapiv1.Hostname("*.example.com"))},
+			},
+		},
+		Status: gwapiv1.GatewayStatus{
+			Addresses: []gwapiv1.GatewayStatusAddress{{Value: "172.18.255.200"}},
+		},
+	}
+	httpRoute := &gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backend"},
+		Spec: gwapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gwapiv1.CommonRouteSpec{
+				ParentRefs: []gwapiv1.ParentReference{{
+					Name:        "eg",
+					Namespace:   ptr.To(gwapiv1.Namespace("envoy-gateway")),
+					SectionName: ptr.To(gwapiv1.SectionName("https")),
+				}},
+			},
+			Rules: []gwapiv1.HTTPRouteRule{{
+				Matches: []gwapiv1.HTTPRouteMatch{{
+					Path: &gwapiv1.HTTPPathMatch{Type: ptr.To(gwapiv1.PathMatchPathPrefix), Value: ptr.To("/api")},
+				}},
+			}},
+		},
+	}
+	grpcRoute := &gwapiv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backend"},
+		Spec: gwapiv1.GRPCRouteSpec{
+			CommonRouteSpec: gwapiv1.CommonRouteSpec{
+				ParentRefs: []gwapiv1.ParentReference{{
+					Name:      "eg",
+					Namespace: ptr.To(gwapiv1.Namespace("envoy-gateway")),
+				}},
+			},
+			Hostnames: []gwapiv1.Hostname{"grpc.example.com"},
+			Rules: []gwapiv1.GRPCRouteRule{{
+				Matches: []gwapiv1.GRPCRouteMatch{{
+					Method: &gwapiv1.GRPCMethodMatch{Service: ptr.To("echo.Echo"), Method: ptr.To("Ping")},
+				}},
+			}},
+		},
+	}
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(gateway, httpRoute, grpcRoute).
+		Build()
+	nn := types.NamespacedName{Namespace: "default", Name: "backend"}
+
+	target, err := resolveProbeTarget(context.Background(), cli, nn, &probeOptions{kind: resource.KindHTTPRoute})
+	require.NoError(t, err)
+	require.Equal(t, &probeTarget{
+		Protocol: probeProtocolHTTP,
+		TLS:      true,
+		Address:  "172.18.255.200:443",
+		Host:     "probe.example.com",
+		Path:     "/api",
+	}, target)
+
+	target, err = resolveProbeTarget(context.Background(), cli, nn, &probeOptions{kind: resource.KindGRPCRoute, address: "localhost"})
+	require.NoError(t, err)
+	require.Equal(t, &probeTarget{
+		Protocol: probeProtocolGRPC,
+		Address:  "localhost:80",
+		Host:     "grpc.example.com",
+		Path:     "/echo.Echo/Ping",
+	}, target)
+
+	_, err = resolveProbeTarget(context.Background(), cli, nn, &probeOptions{kind: resource.KindHTTPRoute, protocol: "tcp"})
+	require.EqualError(t, err, "unsupported protocol tcp, must be one of http, grpc or websocket")
+}
+
+func TestProbeHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "www.example.com" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		host     string
+		path     string
+		expected *probeResult
+	}{
+		{
+			host:     "www.example.com",
+			path:     "/",
+			expected: &probeResult{Status: "200", OK: true, Reason: "OK"},
+		},
+		{
+			host:     "www.example.com",
+			path:     "/down",
+			expected: &probeResult{Status: "503", Reason: "backend unreachable"},
+		},
+		{
+			host:     "unknown.example.com",
+			path:     "/",
+			expected: &probeResult{Status: "404", Reason: "not found, no route matched the request or the backend returned it"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.host+tc.path, func(t *testing.T) {
+			target := &probeTarget{
+				Protocol: probeProtocolHTTP,
+				Address:  server.Listener.Addr().String(),
+				Host:     tc.host,
+				Path:     tc.path,
+			}
+			result, err := probeHTTP(context.Background(), target, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
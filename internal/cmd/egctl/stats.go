@@ -17,6 +17,7 @@ func newStatsCommand() *cobra.Command {
 	}
 
 	c.AddCommand(newEnvoyStatsCmd())
+	c.AddCommand(newRouteStatsCmd())
 
 	return c
 }
@@ -0,0 +1,323 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/kubernetes"
+)
+
+const (
+	upstreamRequestTotal = "upstream_rq_total"
+	upstreamRequest5xx   = "upstream_rq_5xx"
+	upstreamRequestTime  = "upstream_rq_time"
+)
+
+func newRouteStatsCmd() *cobra.Command {
+	var (
+		routeNamespace, proxyNamespace string
+		interval                       time.Duration
+	)
+
+	routeStatsCmd := &cobra.Command{
+		Use:   "route <name> -n <namespace>",
+		Short: "Summarizes the live traffic of an HTTPRoute",
+		Long: `Summarize the live traffic of each rule of an HTTPRoute, aggregated over the Envoy proxies of its parent Gateways.
+The request rate and error rate are computed from two samples of the cluster stats of each rule taken an interval apart,
+and the p99 is the highest upstream request time p99 reported by the proxies.`,
+		Example: `  # Summarize the live traffic of an HTTPRoute.
+  egctl experimental stats route backend -n default
+
+  # Summarize the live traffic of an HTTPRoute over 30 seconds.
+  egctl experimental stats route backend -n default --interval 30s
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			return runRouteStats(c.Context(), c.OutOrStdout(), types.NamespacedName{Namespace: routeNamespace, Name: args[0]}, proxyNamespace, interval)
+		},
+	}
+	routeStatsCmd.PersistentFlags().StringVarP(&routeNamespace, "namespace", "n", "default", "Namespace of the HTTPRoute.")
+	routeStatsCmd.PersistentFlags().StringVar(&proxyNamespace, "proxy-namespace", "envoy-gateway-system", "Namespace where envoy proxy pods are installed.")
+	routeStatsCmd.PersistentFlags().DurationVar(&interval, "interval", 5*time.Second, "Interval between the two samples of stats used to compute the rates.")
+
+	return routeStatsCmd
+}
+
+func runRouteStats(ctx context.Context, w io.Writer, routeNN types.NamespacedName, proxyNamespace string, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	k8sClient, err := newK8sClient()
+	if err != nil {
+		return err
+	}
+	route := &gwapiv1.HTTPRoute{}
+	if err := k8sClient.Get(ctx, routeNN, route); err != nil {
+		return fmt.Errorf("failed to get HTTPRoute %s: %w", routeNN, err)
+	}
+
+	kubeClient, err := getCLIClient()
+	if err != nil {
+		return err
+	}
+	pods, err := fetchRouteProxyPods(kubeClient, route, proxyNamespace)
+	if err != nil {
+		return err
+	}
+
+	clusters := routeRuleClusters(route)
+	filter := "^cluster\\." + regexp.QuoteMeta(routeStatPrefix(route))
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		errs    error
+		summary = newRouteStatsSummary(clusters)
+	)
+	wg.Add(len(pods))
+	for _, pod := range pods {
+		go func(pod types.NamespacedName) {
+			defer wg.Done()
+			before, after, err := sampleRouteStats(kubeClient, pod, filter, interval)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = errors.Join(errs, err)
+				return
+			}
+			summary.add(before, after)
+		}(pod)
+	}
+	wg.Wait()
+	if errs != nil {
+		return errs
+	}
+
+	summary.write(w, interval)
+	return nil
+}
+
+// fetchRouteProxyPods returns the running envoy proxy pods of the Gateways the route is attached to.
+func fetchRouteProxyPods(kubeClient kubernetes.CLIClient, route *gwapiv1.HTTPRoute, proxyNamespace string) ([]types.NamespacedName, error) {
+	var pods []types.NamespacedName
+	seen := map[types.NamespacedName]bool{}
+	for _, parentRef := range route.Spec.ParentRefs {
+		if parentRef.Kind != nil && string(*parentRef.Kind) != resource.KindGateway {
+			continue
+		}
+		gatewayNamespace := route.Namespace
+		if parentRef.Namespace != nil {
+			gatewayNamespace = string(*parentRef.Namespace)
+		}
+
+		var selectors []string
+		for k, v := range gatewayapi.GatewayOwnerLabels(gatewayNamespace, string(parentRef.Name)) {
+			selectors = append(selectors, k+"="+v)
+		}
+		podList, err := kubeClient.PodsForSelector(proxyNamespace, selectors...)
+		if err != nil {
+			return nil, fmt.Errorf("list pods of Gateway %s/%s failed: %w", gatewayNamespace, parentRef.Name, err)
+		}
+		for i := range podList.Items {
+			pod := types.NamespacedName{Namespace: podList.Items[i].Namespace, Name: podList.Items[i].Name}
+			if podList.Items[i].Status.Phase != "Running" || seen[pod] {
+				continue
+			}
+			seen[pod] = true
+			pods = append(pods, pod)
+		}
+	}
+
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no running envoy proxy pods found for HTTPRoute %s/%s in namespace %s", route.Namespace, route.Name, proxyNamespace)
+	}
+	return pods, nil
+}
+
+// routeStatPrefix returns the prefix of the names of the clusters generated for the route.
+func routeStatPrefix(route *gwapiv1.HTTPRoute) string {
+	return fmt.Sprintf("%s/%s/%s/", strings.ToLower(resource.KindHTTPRoute), route.Namespace, route.Name)
+}
+
+// routeRuleClusters returns the names of the clusters generated for each rule of the route.
+func routeRuleClusters(route *gwapiv1.HTTPRoute) []string {
+	clusters := make([]string, 0, len(route.Spec.Rules))
+	for i := range route.Spec.Rules {
+		clusters = append(clusters, fmt.Sprintf("%srule/%d", routeStatPrefix(route), i))
+	}
+	return clusters
+}
+
+// sampleRouteStats takes two samples of the stats matching the filter, an interval apart.
+func sampleRouteStats(kubeClient kubernetes.CLIClient, pod types.NamespacedName, filter string, interval time.Duration) (*envoyStatsSample, *envoyStatsSample, error) {
+	fw, err := portForwarder(kubeClient, pod, adminPort)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize pod-forwarding for %s: %w", pod, err)
+	}
+	if err := fw.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to start port forwarding for pod %s: %w", pod, err)
+	}
+	defer fw.Stop()
+
+	path := "stats?format=json&filter=" + url.QueryEscape(filter)
+	sample := func() (*envoyStatsSample, error) {
+		out, err := statsRequest(fw.Address(), path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats on envoy for pod %s: %w", pod, err)
+		}
+		return parseEnvoyStats(out)
+	}
+
+	before, err := sample()
+	if err != nil {
+		return nil, nil, err
+	}
+	time.Sleep(interval)
+	after, err := sample()
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+// envoyStatsSample holds the counters and the p99 of the histograms of an Envoy stats response.
+type envoyStatsSample struct {
+	counters map[string]uint64
+	p99      map[string]float64
+}
+
+// parseEnvoyStats parses the response of the Envoy admin stats endpoint in JSON format.
+func parseEnvoyStats(in []byte) (*envoyStatsSample, error) {
+	var stats struct {
+		Stats []struct {
+			Name       string          `json:"name"`
+			Value      json.RawMessage `json:"value"`
+			Histograms *struct {
+				SupportedQuantiles []float64 `json:"supported_quantiles"`
+				ComputedQuantiles  []struct {
+					Name   string `json:"name"`
+					Values []struct {
+						Interval   *float64 `json:"interval"`
+						Cumulative *float64 `json:"cumulative"`
+					} `json:"values"`
+				} `json:"computed_quantiles"`
+			} `json:"histograms"`
+		} `json:"stats"`
+	}
+	if err := json.Unmarshal(in, &stats); err != nil {
+		return nil, fmt.Errorf("failed to parse envoy stats: %w", err)
+	}
+
+	sample := &envoyStatsSample{
+		counters: map[string]uint64{},
+		p99:      map[string]float64{},
+	}
+	for _, stat := range stats.Stats {
+		if stat.Histograms == nil {
+			// Text readouts have string values, they are not needed.
+			if value, err := strconv.ParseUint(string(stat.Value), 10, 64); err == nil {
+				sample.counters[stat.Name] = value
+			}
+			continue
+		}
+
+		p99Index := -1
+		for i, q := range stat.Histograms.SupportedQuantiles {
+			if q == 99 {
+				p99Index = i
+			}
+		}
+		if p99Index < 0 {
+			continue
+		}
+		for _, h := range stat.Histograms.ComputedQuantiles {
+			if p99Index >= len(h.Values) {
+				continue
+			}
+			// Prefer the quantile of the latest interval, which reflects the live traffic.
+			switch v := h.Values[p99Index]; {
+			case v.Interval != nil:
+				sample.p99[h.Name] = *v.Interval
+			case v.Cumulative != nil:
+				sample.p99[h.Name] = *v.Cumulative
+			}
+		}
+	}
+	return sample, nil
+}
+
+// routeStatsSummary aggregates the traffic of the rules of a route over multiple proxies.
+type routeStatsSummary struct {
+	clusters []string
+	requests map[string]uint64
+	errors   map[string]uint64
+	p99      map[string]float64
+}
+
+func newRouteStatsSummary(clusters []string) *routeStatsSummary {
+	return &routeStatsSummary{
+		clusters: clusters,
+		requests: map[string]uint64{},
+		errors:   map[string]uint64{},
+		p99:      map[string]float64{},
+	}
+}
+
+// add adds the traffic of a proxy, observed between the two samples.
+func (s *routeStatsSummary) add(before, after *envoyStatsSample) {
+	for _, cluster := range s.clusters {
+		prefix := "cluster." + cluster + "."
+		s.requests[cluster] += counterDelta(before, after, prefix+upstreamRequestTotal)
+		s.errors[cluster] += counterDelta(before, after, prefix+upstreamRequest5xx)
+		if p99, ok := after.p99[prefix+upstreamRequestTime]; ok && p99 > s.p99[cluster] {
+			s.p99[cluster] = p99
+		}
+	}
+}
+
+func (s *routeStatsSummary) write(w io.Writer, interval time.Duration) {
+	table := newStatusTableWriter(w)
+	header := []string{"RULE", "CLUSTER", "RPS", "ERROR RATE", "P99"}
+	var body [][]string
+	for i, cluster := range s.clusters {
+		errorRate := "-"
+		if s.requests[cluster] > 0 {
+			errorRate = fmt.Sprintf("%.2f%%", float64(s.errors[cluster])*100/float64(s.requests[cluster]))
+		}
+		p99 := "-"
+		if v, ok := s.p99[cluster]; ok && s.requests[cluster] > 0 {
+			p99 = fmt.Sprintf("%gms", v)
+		}
+		body = append(body, []string{
+			strconv.Itoa(i),
+			cluster,
+			fmt.Sprintf("%.2f", float64(s.requests[cluster])/interval.Seconds()),
+			errorRate,
+			p99,
+		})
+	}
+	writeStatusTable(table, header, body)
+	_ = table.Flush()
+}
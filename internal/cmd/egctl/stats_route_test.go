@@ -0,0 +1,58 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestRouteStatsSummary(t *testing.T) {
+	route := &gwapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "backend",
+		},
+		Spec: gwapiv1.HTTPRouteSpec{
+			Rules: []gwapiv1.HTTPRouteRule{{}, {}},
+		},
+	}
+
+	before, err := parseEnvoyStats([]byte(`{"stats":[
+{"name":"cluster.httproute/default/backend/rule/0.upstream_rq_total","value":100},
+{"name":"cluster.httproute/default/backend/rule/0.upstream_rq_5xx","value":10},
+{"name":"cluster.httproute/default/backend/rule/1.upstream_rq_total","value":5},
+{"name":"cluster.httproute/default/backend/rule/0.name","value":"ignored"}
+]}`))
+	require.NoError(t, err)
+
+	after, err := parseEnvoyStats([]byte(`{"stats":[
+{"name":"cluster.httproute/default/backend/rule/0.upstream_rq_total","value":300},
+{"name":"cluster.httproute/default/backend/rule/0.upstream_rq_5xx","value":20},
+{"name":"cluster.httproute/default/backend/rule/1.upstream_rq_total","value":5},
+{"histograms":{"supported_quantiles":[0,25,50,75,90,95,99,99.5,99.9,100],"computed_quantiles":[
+{"name":"cluster.httproute/default/backend/rule/0.upstream_rq_time","values":[
+{"interval":1,"cumulative":1},{"interval":2,"cumulative":2},{"interval":3,"cumulative":3},{"interval":4,"cumulative":4},
+{"interval":5,"cumulative":5},{"interval":6,"cumulative":6},{"interval":42,"cumulative":50},{"interval":43,"cumulative":51},
+{"interval":44,"cumulative":52},{"interval":45,"cumulative":53}]}]}}
+]}`))
+	require.NoError(t, err)
+
+	summary := newRouteStatsSummary(routeRuleClusters(route))
+	summary.add(before, after)
+
+	b := &bytes.Buffer{}
+	summary.write(b, 10*time.Second)
+	require.Equal(t, `RULE      CLUSTER                            RPS       ERROR RATE   P99
+0         httproute/default/backend/rule/0   20.00     5.00%        42ms
+1         httproute/default/backend/rule/1   0.00      -            -
+`, b.String())
+}
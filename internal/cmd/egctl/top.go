@@ -0,0 +1,240 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	kube "github.com/envoyproxy/gateway/internal/kubernetes"
+)
+
+const (
+	// topStatsFilter selects the stats of the listeners, HTTP connection managers and clusters.
+	topStatsFilter = `^(listener|http|cluster)\.`
+	// clearScreen moves the cursor to the top left corner and clears the terminal.
+	clearScreen = "\033[H\033[2J"
+)
+
+func newTopCommand() *cobra.Command {
+	var (
+		podName, podNamespace string
+		interval              time.Duration
+		iterations            int
+	)
+
+	topCommand := &cobra.Command{
+		Use:   "top [<name>] -n <namespace>",
+		Short: "Display the live traffic of the listeners and clusters of Envoy proxies",
+		Long: `Display the active connections, connection rate, request rate, error rate and upstream health of each listener
+and cluster, refreshed periodically from the admin stats of the Envoy proxies.
+The traffic of all the selected proxies is summed, all the proxies of the namespace are selected when no name or label is given.`,
+		Example: `  # Display the live traffic of all the Envoy proxies in the envoy-gateway-system namespace.
+  egctl experimental top -n envoy-gateway-system
+
+  # Display the live traffic of the Envoy proxies of a Gateway.
+  egctl experimental top -n envoy-gateway-system -l gateway.envoyproxy.io/owning-gateway-name=eg
+
+  # Display the live traffic of a single Envoy proxy, refreshed every 5 seconds.
+  egctl experimental top <pod-name> -n envoy-gateway-system --interval 5s
+`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				cmd.Println(cmd.UsageString())
+				return fmt.Errorf("top accepts at most one pod name")
+			}
+			if len(args) > 0 && len(labelSelectors) > 0 {
+				cmd.Println(cmd.UsageString())
+				return fmt.Errorf("name cannot be provided when a selector is specified")
+			}
+			return nil
+		},
+		RunE: func(c *cobra.Command, args []string) error {
+			if interval <= 0 {
+				return fmt.Errorf("interval must be positive, got %s", interval)
+			}
+			kubeClient, err := getCLIClient()
+			if err != nil {
+				return err
+			}
+			if len(args) != 0 {
+				podName = args[0]
+			}
+			pods, err := fetchRunningEnvoyPods(kubeClient, types.NamespacedName{Namespace: podNamespace, Name: podName}, labelSelectors, false)
+			if err != nil {
+				return err
+			}
+
+			return runTop(c.Context(), c.OutOrStdout(), kubeClient, pods, interval, iterations)
+		},
+	}
+
+	topCommand.PersistentFlags().StringArrayVarP(&labelSelectors, "labels", "l", nil, "Labels to select the envoy proxy pods.")
+	topCommand.PersistentFlags().StringVarP(&podNamespace, "namespace", "n", "envoy-gateway-system", "Namespace where envoy proxy pods are installed.")
+	topCommand.PersistentFlags().DurationVar(&interval, "interval", 2*time.Second, "Interval between two refreshes of the view.")
+	topCommand.PersistentFlags().IntVar(&iterations, "iterations", 0, "Number of refreshes before exiting, 0 to refresh until interrupted.")
+
+	return topCommand
+}
+
+func runTop(ctx context.Context, w io.Writer, kubeClient kube.CLIClient, pods []types.NamespacedName, interval time.Duration, iterations int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go waitForSignal(ctx, cancel)
+
+	fws := make([]kube.PortForwarder, 0, len(pods))
+	defer func() {
+		for _, fw := range fws {
+			fw.Stop()
+		}
+	}()
+	for _, pod := range pods {
+		fw, err := portForwarder(kubeClient, pod, adminPort)
+		if err != nil {
+			return fmt.Errorf("failed to initialize pod-forwarding for %s: %w", pod, err)
+		}
+		if err := fw.Start(); err != nil {
+			return fmt.Errorf("failed to start port forwarding for pod %s: %w", pod, err)
+		}
+		fws = append(fws, fw)
+	}
+
+	sample := func() (*envoyStatsSample, time.Time, error) {
+		s, err := sampleTopStats(fws)
+		return s, time.Now(), err
+	}
+	before, beforeTime, err := sample()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; iterations == 0 || i < iterations; i++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		after, afterTime, err := sample()
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(w, clearScreen)
+		writeTopView(w, before, after, afterTime.Sub(beforeTime))
+		before, beforeTime = after, afterTime
+	}
+	return nil
+}
+
+// sampleTopStats returns the stats of the listeners and clusters, summed over the proxies.
+func sampleTopStats(fws []kube.PortForwarder) (*envoyStatsSample, error) {
+	total := &envoyStatsSample{counters: map[string]uint64{}}
+	for _, fw := range fws {
+		out, err := statsRequest(fw.Address(), "stats?format=json&filter="+url.QueryEscape(topStatsFilter))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats on envoy: %w", err)
+		}
+		sample, err := parseEnvoyStats(out)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range sample.counters {
+			total.counters[name] += value
+		}
+	}
+	return total, nil
+}
+
+// writeTopView writes the traffic of the listeners and clusters between the two samples.
+func writeTopView(w io.Writer, before, after *envoyStatsSample, elapsed time.Duration) {
+	rate := func(name string) string {
+		return fmt.Sprintf("%.2f", float64(counterDelta(before, after, name))/elapsed.Seconds())
+	}
+	errorRate := func(requests, errors string) string {
+		total := counterDelta(before, after, requests)
+		if total == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.2f%%", float64(counterDelta(before, after, errors))*100/float64(total))
+	}
+
+	table := newStatusTableWriter(w)
+	var listenerRows [][]string
+	for _, listener := range statNames(after, "listener.", ".downstream_cx_active") {
+		prefix := "listener." + listener + "."
+		row := []string{
+			listener,
+			strconv.FormatUint(after.counters[prefix+"downstream_cx_active"], 10),
+			rate(prefix + "downstream_cx_total"),
+			"-",
+			"-",
+		}
+		// The stats of the HTTP connection manager are prefixed by the protocol and the port, like http-10080.
+		if port := listener[strings.LastIndex(listener, "_")+1:]; port != "" {
+			for _, hcm := range []string{"http-" + port, "https-" + port} {
+				if _, ok := after.counters["http."+hcm+".downstream_rq_total"]; ok {
+					row[3] = rate("http." + hcm + ".downstream_rq_total")
+					row[4] = errorRate("http."+hcm+".downstream_rq_total", "http."+hcm+".downstream_rq_5xx")
+				}
+			}
+		}
+		listenerRows = append(listenerRows, row)
+	}
+	writeStatusTable(table, []string{"LISTENER", "ACTIVE CONNECTIONS", "CONNECTIONS/S", "RPS", "ERROR RATE"}, listenerRows)
+	_, _ = fmt.Fprintln(table)
+
+	var clusterRows [][]string
+	for _, cluster := range statNames(after, "cluster.", ".upstream_cx_active") {
+		prefix := "cluster." + cluster + "."
+		clusterRows = append(clusterRows, []string{
+			cluster,
+			strconv.FormatUint(after.counters[prefix+"upstream_cx_active"], 10),
+			rate(prefix + upstreamRequestTotal),
+			errorRate(prefix+upstreamRequestTotal, prefix+upstreamRequest5xx),
+			fmt.Sprintf("%d/%d", after.counters[prefix+"membership_healthy"], after.counters[prefix+"membership_total"]),
+		})
+	}
+	writeStatusTable(table, []string{"CLUSTER", "ACTIVE CONNECTIONS", "RPS", "ERROR RATE", "HEALTHY"}, clusterRows)
+	_ = table.Flush()
+}
+
+// statNames returns the sorted names between the prefix and the suffix of the stats, without the
+// admin listener and the stats per worker.
+func statNames(sample *envoyStatsSample, prefix, suffix string) []string {
+	var names []string
+	for stat := range sample.counters {
+		if !strings.HasPrefix(stat, prefix) || !strings.HasSuffix(stat, suffix) {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(stat, prefix), suffix)
+		if name == "admin" || strings.Contains(name, ".worker_") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// counterDelta returns the increase of a counter between the two samples.
+func counterDelta(before, after *envoyStatsSample, name string) uint64 {
+	// Counters are reset when the listener or cluster is updated, only the later sample is relevant then.
+	if after.counters[name] < before.counters[name] {
+		return after.counters[name]
+	}
+	return after.counters[name] - before.counters[name]
+}
@@ -0,0 +1,57 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTopView(t *testing.T) {
+	before := &envoyStatsSample{counters: map[string]uint64{
+		"listener.0.0.0.0_10080.downstream_cx_active":                 4,
+		"listener.0.0.0.0_10080.downstream_cx_total":                  100,
+		"listener.0.0.0.0_10080.worker_0.downstream_cx_active":        4,
+		"listener.admin.downstream_cx_active":                         1,
+		"http.http-10080.downstream_rq_total":                         1000,
+		"http.http-10080.downstream_rq_5xx":                           10,
+		"cluster.httproute/default/backend/rule/0.upstream_cx_active": 2,
+		"cluster.httproute/default/backend/rule/0.upstream_rq_total":  900,
+		"cluster.httproute/default/backend/rule/0.upstream_rq_5xx":    10,
+	}}
+	after := &envoyStatsSample{counters: map[string]uint64{
+		"listener.0.0.0.0_10080.downstream_cx_active":                 6,
+		"listener.0.0.0.0_10080.downstream_cx_total":                  120,
+		"listener.0.0.0.0_10080.worker_0.downstream_cx_active":        6,
+		"listener.0.0.0.0_10443.downstream_cx_active":                 0,
+		"listener.0.0.0.0_10443.downstream_cx_total":                  0,
+		"listener.admin.downstream_cx_active":                         1,
+		"http.http-10080.downstream_rq_total":                         1400,
+		"http.http-10080.downstream_rq_5xx":                           30,
+		"cluster.httproute/default/backend/rule/0.upstream_cx_active": 3,
+		"cluster.httproute/default/backend/rule/0.upstream_rq_total":  1300,
+		"cluster.httproute/default/backend/rule/0.upstream_rq_5xx":    30,
+		"cluster.httproute/default/backend/rule/0.membership_healthy": 2,
+		"cluster.httproute/default/backend/rule/0.membership_total":   3,
+		"cluster.prometheus_stats.upstream_cx_active":                 0,
+		"cluster.prometheus_stats.membership_healthy":                 1,
+		"cluster.prometheus_stats.membership_total":                   1,
+	}}
+
+	b := &bytes.Buffer{}
+	writeTopView(b, before, after, 10*time.Second)
+	require.Equal(t, `LISTENER        ACTIVE CONNECTIONS   CONNECTIONS/S   RPS       ERROR RATE
+0.0.0.0_10080   6                    2.00            40.00     5.00%
+0.0.0.0_10443   0                    0.00            -         -
+
+CLUSTER                            ACTIVE CONNECTIONS   RPS       ERROR RATE   HEALTHY
+httproute/default/backend/rule/0   3                    40.00     5.00%        2/3
+prometheus_stats                   0                    0.00      -            1/1
+`, b.String())
+}
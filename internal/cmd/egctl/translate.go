@@ -7,10 +7,13 @@ package egctl
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"sort"
 
 	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -49,7 +52,7 @@ type TranslationResult struct {
 func newTranslateCommand() *cobra.Command {
 	var (
 		inFile, inType, output, resourceType string
-		addMissingResources                  bool
+		addMissingResources, diff            bool
 		outTypes                             []string
 		dnsDomain                            string
 		namespace                            string
@@ -91,16 +94,22 @@ func newTranslateCommand() *cobra.Command {
 
   # Translate Gateway API Resources into IR in YAML output,
   egctl experimental translate --from gateway-api --to ir --output yaml --file <input file>
+
+  # Show the xDS Resources added, removed or modified by a new version of Gateway API Resources.
+  egctl experimental translate --diff <old input file> <new input file>
 	`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if diff {
+				if len(args) != 2 {
+					return fmt.Errorf("--diff requires the old and the new input files as arguments")
+				}
+				return runTranslateDiff(cmd.OutOrStdout(), args[0], args[1], output, addMissingResources, namespace, dnsDomain)
+			}
 			return translate(cmd.OutOrStdout(), inFile, inType, outTypes, output, resourceType, addMissingResources, namespace, dnsDomain)
 		},
 	}
 
 	translateCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file.")
-	if err := translateCommand.MarkPersistentFlagRequired("file"); err != nil {
-		return nil
-	}
 	translateCommand.PersistentFlags().StringVarP(&inType, "from", "", gatewayAPIType, getValidInputTypesStr())
 	translateCommand.PersistentFlags().StringSliceVarP(&outTypes, "to", "", []string{gatewayAPIType, xdsType}, getValidOutputTypesStr())
 	translateCommand.PersistentFlags().StringVarP(&output, "output", "o", yamlOutput, "One of 'yaml' or 'json'")
@@ -108,6 +117,7 @@ func newTranslateCommand() *cobra.Command {
 	translateCommand.PersistentFlags().BoolVarP(&addMissingResources, "add-missing-resources", "", false, "Provides dummy resources if missed")
 	translateCommand.PersistentFlags().StringVarP(&dnsDomain, "dns-domain", "", "cluster.local", "DNS domain used by k8s services, default is cluster.local")
 	translateCommand.PersistentFlags().StringVarP(&namespace, "namespace", "n", "envoy-gateway-system", "Namespace where envoy gateway is installed.")
+	translateCommand.PersistentFlags().BoolVarP(&diff, "diff", "", false, "Show the xDS resources added, removed or modified between the two input files given as arguments.")
 
 	return translateCommand
 }
@@ -190,10 +200,44 @@ func getInputBytes(inFile string) ([]byte, error) {
 		}
 		return []byte(input), nil
 	}
+	// Get input from all the manifests of a directory
+	if info, err := os.Stat(inFile); err == nil && info.IsDir() {
+		return getDirInputBytes(inFile)
+	}
 	// Get input from file
 	return os.ReadFile(inFile)
 }
 
+// getDirInputBytes concatenates the YAML and JSON manifests found in the directory and its
+// subdirectories, in lexical order.
+func getDirInputBytes(dir string) ([]byte, error) {
+	var input []byte
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch filepath.Ext(path) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if len(input) > 0 {
+			input = append(input, []byte("---\n")...)
+		}
+		input = append(input, content...)
+		if !bytes.HasSuffix(input, []byte("\n")) {
+			input = append(input, '\n')
+		}
+		return nil
+	})
+	return input, err
+}
+
 func validate(inFile, inType string, outTypes []string, resourceType string) error {
 	if !isValidInputType(inType) {
 		return fmt.Errorf("%s is not a valid input type. %s", inType, getValidInputTypesStr())
@@ -332,47 +376,21 @@ func translateGatewayAPIToGatewayAPI(resources *resource.Resources) (resource.Re
 }
 
 func translateGatewayAPIToXds(namespace, dnsDomain string, resourceType string, resources *resource.Resources) (map[string]any, error) {
-	if resources.GatewayClass == nil {
-		return nil, fmt.Errorf("the GatewayClass resource is required")
-	}
-
-	// Translate from Gateway API to Xds IR
-	gTranslator := &gatewayapi.Translator{
-		GatewayControllerName:   string(resources.GatewayClass.Spec.ControllerName),
-		GatewayClassName:        gwapiv1.ObjectName(resources.GatewayClass.Name),
-		GlobalRateLimitEnabled:  true,
-		EndpointRoutingDisabled: true,
-		EnvoyPatchPolicyEnabled: true,
-		BackendEnabled:          true,
+	xdsResources, err := translateGatewayAPIToXdsResources(namespace, dnsDomain, resources)
+	if err != nil {
+		return nil, err
 	}
-	gRes, _ := gTranslator.Translate(resources)
 
 	keys := []string{}
-	for key := range gRes.XdsIR {
+	for key := range xdsResources {
 		keys = append(keys, key)
 	}
 	// Make output stable since XdsIR is a map
 	sort.Strings(keys)
 
-	// Translate from Xds IR to Xds
 	result := make(map[string]interface{})
 	for _, key := range keys {
-		val := gRes.XdsIR[key]
-		xTranslator := &translator.Translator{
-			// Set some default settings for translation
-			GlobalRateLimit: &translator.GlobalRateLimitSettings{
-				ServiceURL: ratelimit.GetServiceURL(namespace, dnsDomain),
-			},
-		}
-		if resources.EnvoyProxyForGatewayClass != nil {
-			xTranslator.FilterOrder = resources.EnvoyProxyForGatewayClass.Spec.FilterOrder
-		}
-		xRes, err := xTranslator.Translate(val)
-		if err != nil {
-			return nil, fmt.Errorf("failed to translate xds ir for key %s value %+v, error:%w", key, val, err)
-		}
-
-		globalConfigs, err := constructConfigDump(resources, xRes)
+		globalConfigs, err := constructConfigDump(resources, xdsResources[key])
 		if err != nil {
 			return nil, err
 		}
@@ -384,11 +402,11 @@ func translateGatewayAPIToXds(namespace, dnsDomain string, resourceType string,
 			data = globalConfigs
 		} else {
 			// Find resource
-			xdsResources, err := findXDSResourceFromConfigDump(rType, globalConfigs)
+			xdsResource, err := findXDSResourceFromConfigDump(rType, globalConfigs)
 			if err != nil {
 				return nil, err
 			}
-			data = xdsResources
+			data = xdsResource
 		}
 
 		out, err := protojson.Marshal(data)
@@ -405,6 +423,45 @@ func translateGatewayAPIToXds(namespace, dnsDomain string, resourceType string,
 	return result, nil
 }
 
+// translateGatewayAPIToXdsResources translates the Gateway API resources to the xDS resources of each xDS IR.
+func translateGatewayAPIToXdsResources(namespace, dnsDomain string, resources *resource.Resources) (map[string]*xds_types.ResourceVersionTable, error) {
+	if resources.GatewayClass == nil {
+		return nil, fmt.Errorf("the GatewayClass resource is required")
+	}
+
+	// Translate from Gateway API to Xds IR
+	gTranslator := &gatewayapi.Translator{
+		GatewayControllerName:   string(resources.GatewayClass.Spec.ControllerName),
+		GatewayClassName:        gwapiv1.ObjectName(resources.GatewayClass.Name),
+		GlobalRateLimitEnabled:  true,
+		EndpointRoutingDisabled: true,
+		EnvoyPatchPolicyEnabled: true,
+		BackendEnabled:          true,
+	}
+	gRes, _ := gTranslator.Translate(resources)
+
+	// Translate from Xds IR to Xds
+	result := make(map[string]*xds_types.ResourceVersionTable, len(gRes.XdsIR))
+	for key, val := range gRes.XdsIR {
+		xTranslator := &translator.Translator{
+			// Set some default settings for translation
+			GlobalRateLimit: &translator.GlobalRateLimitSettings{
+				ServiceURL: ratelimit.GetServiceURL(namespace, dnsDomain),
+			},
+		}
+		if resources.EnvoyProxyForGatewayClass != nil {
+			xTranslator.FilterOrder = resources.EnvoyProxyForGatewayClass.Spec.FilterOrder
+		}
+		xRes, err := xTranslator.Translate(val)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate xds ir for key %s value %+v, error:%w", key, val, err)
+		}
+		result[key] = xRes
+	}
+
+	return result, nil
+}
+
 // printOutput prints the echo-backed gateway API and xDS output
 func printOutput(w io.Writer, result TranslationResult, output string) error {
 	var (
@@ -0,0 +1,173 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	xds_types "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	xdsResourceAdded    = "Added"
+	xdsResourceRemoved  = "Removed"
+	xdsResourceModified = "Modified"
+)
+
+// xdsResourceDiff is the change of an xDS resource between the translations of two inputs.
+type xdsResourceDiff struct {
+	// IR is the key of the xDS IR the resource belongs to.
+	IR     string `json:"ir"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Change string `json:"change"`
+	// Diff is the unified diff of the resource in YAML.
+	Diff string `json:"diff"`
+}
+
+// runTranslateDiff translates both inputs to xDS, and prints the xDS resources added, removed or modified
+// by the new input.
+func runTranslateDiff(w io.Writer, oldFile, newFile, output string, addMissingResources bool, namespace, dnsDomain string) error {
+	oldResources, err := translateFileToXdsResources(oldFile, addMissingResources, namespace, dnsDomain)
+	if err != nil {
+		return fmt.Errorf("unable to translate %s: %w", oldFile, err)
+	}
+	newResources, err := translateFileToXdsResources(newFile, addMissingResources, namespace, dnsDomain)
+	if err != nil {
+		return fmt.Errorf("unable to translate %s: %w", newFile, err)
+	}
+
+	diffs, err := diffXdsResources(oldResources, newResources)
+	if err != nil {
+		return err
+	}
+
+	var out []byte
+	switch output {
+	case jsonOutput:
+		out, err = json.MarshalIndent(diffs, "", "  ")
+	default:
+		out, err = yaml.Marshal(diffs)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, strings.TrimSuffix(string(out), "\n"))
+	return err
+}
+
+func translateFileToXdsResources(inFile string, addMissingResources bool, namespace, dnsDomain string) (map[string]*xds_types.ResourceVersionTable, error) {
+	inBytes, err := getInputBytes(inFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read input file: %w", err)
+	}
+	resources, err := resource.LoadResourcesFromYAMLBytes(inBytes, addMissingResources)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+	return translateGatewayAPIToXdsResources(namespace, dnsDomain, resources)
+}
+
+// diffXdsResources compares the xDS resources of each xDS IR, sorted by IR, type and name.
+func diffXdsResources(oldResources, newResources map[string]*xds_types.ResourceVersionTable) ([]xdsResourceDiff, error) {
+	oldYAML, err := xdsResourcesToYAML(oldResources)
+	if err != nil {
+		return nil, err
+	}
+	newYAML, err := xdsResourcesToYAML(newResources)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []xdsResourceDiff{}
+	for id, newY := range newYAML {
+		oldY, ok := oldYAML[id]
+		switch {
+		case !ok:
+			diffs = append(diffs, newXdsResourceDiff(id, xdsResourceAdded, "", newY))
+		case oldY != newY:
+			diffs = append(diffs, newXdsResourceDiff(id, xdsResourceModified, oldY, newY))
+		}
+	}
+	for id, oldY := range oldYAML {
+		if _, ok := newYAML[id]; !ok {
+			diffs = append(diffs, newXdsResourceDiff(id, xdsResourceRemoved, oldY, ""))
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].IR != diffs[j].IR {
+			return diffs[i].IR < diffs[j].IR
+		}
+		if diffs[i].Type != diffs[j].Type {
+			return diffs[i].Type < diffs[j].Type
+		}
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs, nil
+}
+
+// xdsResourceID identifies an xDS resource across translations.
+type xdsResourceID struct {
+	ir, typ, name string
+}
+
+func xdsResourcesToYAML(tables map[string]*xds_types.ResourceVersionTable) (map[xdsResourceID]string, error) {
+	result := map[xdsResourceID]string{}
+	for ir, table := range tables {
+		for typeURL, resources := range table.XdsResources {
+			// Use the short name of the type, like Listener or Cluster.
+			typ := typeURL[strings.LastIndex(typeURL, ".")+1:]
+			for _, r := range resources {
+				out, err := protojson.Marshal(r)
+				if err != nil {
+					return nil, err
+				}
+				// Converting to YAML also sorts the fields, which makes the diff stable.
+				y, err := yaml.JSONToYAML(out)
+				if err != nil {
+					return nil, err
+				}
+				result[xdsResourceID{ir: ir, typ: typ, name: cachev3.GetResourceName(r)}] = string(y)
+			}
+		}
+	}
+	return result, nil
+}
+
+func newXdsResourceDiff(id xdsResourceID, change, oldYAML, newYAML string) xdsResourceDiff {
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitDiffLines(oldYAML),
+		B:        splitDiffLines(newYAML),
+		FromFile: "old",
+		ToFile:   "new",
+		Context:  3,
+	})
+	return xdsResourceDiff{
+		IR:     id.ir,
+		Type:   id.typ,
+		Name:   id.name,
+		Change: change,
+		Diff:   diff,
+	}
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(s, "\n"))
+}
@@ -0,0 +1,80 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package egctl
+
+import (
+	"testing"
+	"time"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	xds_types "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func TestDiffXdsResources(t *testing.T) {
+	cluster := func(name string, timeout time.Duration) types.Resource {
+		return &clusterv3.Cluster{Name: name, ConnectTimeout: durationpb.New(timeout)}
+	}
+
+	oldResources := map[string]*xds_types.ResourceVersionTable{
+		"default/eg": {
+			XdsResources: xds_types.XdsResources{
+				resourcev3.ClusterType: {
+					cluster("httproute/default/backend/rule/0", 5*time.Second),
+					cluster("httproute/default/legacy/rule/0", 5*time.Second),
+				},
+				resourcev3.ListenerType: {
+					&listenerv3.Listener{Name: "default/eg/http"},
+				},
+			},
+		},
+	}
+	newResources := map[string]*xds_types.ResourceVersionTable{
+		"default/eg": {
+			XdsResources: xds_types.XdsResources{
+				resourcev3.ClusterType: {
+					cluster("httproute/default/backend/rule/0", 10*time.Second),
+					cluster("httproute/default/backend/rule/1", 5*time.Second),
+				},
+				resourcev3.ListenerType: {
+					&listenerv3.Listener{Name: "default/eg/http"},
+				},
+			},
+		},
+	}
+
+	diffs, err := diffXdsResources(oldResources, newResources)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+
+	require.Equal(t, xdsResourceDiff{
+		IR:     "default/eg",
+		Type:   "Cluster",
+		Name:   "httproute/default/backend/rule/0",
+		Change: xdsResourceModified,
+		Diff: `--- old
++++ new
+@@ -1,2 +1,2 @@
+-connectTimeout: 5s
++connectTimeout: 10s
+ name: httproute/default/backend/rule/0
+`,
+	}, diffs[0])
+
+	require.Equal(t, "httproute/default/backend/rule/1", diffs[1].Name)
+	require.Equal(t, xdsResourceAdded, diffs[1].Change)
+	require.Equal(t, "httproute/default/legacy/rule/0", diffs[2].Name)
+	require.Equal(t, xdsResourceRemoved, diffs[2].Change)
+
+	diffs, err = diffXdsResources(oldResources, oldResources)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+}
@@ -7,41 +7,59 @@ package egctl
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 )
 
 func newValidateCommand() *cobra.Command {
-	var inFile string
+	var (
+		inFile              string
+		addMissingResources bool
+	)
 
 	validateCommand := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate Gateway API Resources from the given file, return all the errors if got any.",
+		Long: `Validate Gateway API Resources from the given file or directory without a cluster.
+Every resource is validated against its CRD schema first. When all of them are valid and a GatewayClass is found,
+the resources are translated the same way Envoy Gateway does, and every condition reporting a problem with a resource,
+like an invalid regex, a sectionName matching no listener or conflicting policies, is printed.
+The command fails when any error is found, so it can be used in CI pipelines.`,
 		Example: `  # Validate Gateway API Resources
   egctl x validate -f <input file>
+
+  # Validate all the Gateway API Resources of a directory, providing dummy resources
+  # for the ones that are referenced but missing, like Services.
+  egctl x validate -f <input directory> --add-missing-resources
 `,
+		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(inFile) == 0 {
 				return fmt.Errorf("-f/--file must be specified")
 			}
 
-			return runValidate(cmd.OutOrStdout(), inFile)
+			return runValidate(cmd.OutOrStdout(), inFile, addMissingResources)
 		},
 	}
 
-	validateCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file.")
+	validateCommand.PersistentFlags().StringVarP(&inFile, "file", "f", "", "Location of input file or directory.")
 	if err := validateCommand.MarkPersistentFlagRequired("file"); err != nil {
 		return nil
 	}
+	validateCommand.PersistentFlags().BoolVarP(&addMissingResources, "add-missing-resources", "", false, "Provides dummy resources if missed")
 
 	return validateCommand
 }
 
-func runValidate(w io.Writer, inFile string) error {
+func runValidate(w io.Writer, inFile string, addMissingResources bool) error {
 	inBytes, err := getInputBytes(inFile)
 	if err != nil {
 		return fmt.Errorf("unable to read input file: %w", err)
@@ -62,10 +80,128 @@ func runValidate(w io.Writer, inFile string) error {
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
 
+	// The semantic validation requires all the resources to be loaded, which is only possible
+	// once they are all valid against their schema.
 	if noErr {
-		_, err = fmt.Fprintln(w, "\033[32mOK\033[0m")
+		errs, err := runSemanticValidate(inBytes, addMissingResources)
+		if err != nil {
+			return err
+		}
+		for _, e := range errs {
+			if _, err := fmt.Fprintln(w, e); err != nil {
+				return err
+			}
+		}
+		noErr = len(errs) == 0
 	}
 
+	if !noErr {
+		return errors.New("validation failed")
+	}
+	_, err = fmt.Fprintln(w, "\033[32mOK\033[0m")
 	return err
 }
+
+// runSemanticValidate translates the resources and returns the problems reported by their conditions.
+func runSemanticValidate(inBytes []byte, addMissingResources bool) ([]string, error) {
+	resources, err := resource.LoadResourcesFromYAMLBytes(inBytes, addMissingResources)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal input: %w", err)
+	}
+	if resources.GatewayClass == nil {
+		return nil, nil
+	}
+
+	translated, err := translateGatewayAPIToGatewayAPI(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	report := func(kind string, obj metav1.Object, parent string, conditions []metav1.Condition) {
+		for _, c := range conditions {
+			if !isProblemCondition(c) {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("%s %s/%s%s: %s=%s (%s) %s",
+				kind, obj.GetNamespace(), obj.GetName(), parent, c.Type, c.Status, c.Reason, c.Message))
+		}
+	}
+	reportParents := func(kind string, obj metav1.Object, parents []gwapiv1.RouteParentStatus) {
+		for _, p := range parents {
+			report(kind, obj, " parentRef "+parentRefString(p.ParentRef, obj.GetNamespace()), p.Conditions)
+		}
+	}
+	reportAncestors := func(kind string, obj metav1.Object, ancestors []gwapiv1a2.PolicyAncestorStatus) {
+		for _, a := range ancestors {
+			report(kind, obj, " ancestor "+parentRefString(a.AncestorRef, obj.GetNamespace()), a.Conditions)
+		}
+	}
+
+	if gc := translated.GatewayClass; gc != nil {
+		report(resource.KindGatewayClass, gc, "", gc.Status.Conditions)
+	}
+	for _, g := range translated.Gateways {
+		report(resource.KindGateway, g, "", g.Status.Conditions)
+		for _, l := range g.Status.Listeners {
+			report(resource.KindGateway, g, " listener "+string(l.Name), l.Conditions)
+		}
+	}
+	for _, r := range translated.HTTPRoutes {
+		reportParents(resource.KindHTTPRoute, r, r.Status.Parents)
+	}
+	for _, r := range translated.GRPCRoutes {
+		reportParents(resource.KindGRPCRoute, r, r.Status.Parents)
+	}
+	for _, r := range translated.TLSRoutes {
+		reportParents(resource.KindTLSRoute, r, r.Status.Parents)
+	}
+	for _, r := range translated.TCPRoutes {
+		reportParents(resource.KindTCPRoute, r, r.Status.Parents)
+	}
+	for _, r := range translated.UDPRoutes {
+		reportParents(resource.KindUDPRoute, r, r.Status.Parents)
+	}
+	for _, p := range translated.ClientTrafficPolicies {
+		reportAncestors(resource.KindClientTrafficPolicy, p, p.Status.Ancestors)
+	}
+	for _, p := range translated.BackendTrafficPolicies {
+		reportAncestors(resource.KindBackendTrafficPolicy, p, p.Status.Ancestors)
+	}
+	for _, p := range translated.SecurityPolicies {
+		reportAncestors(resource.KindSecurityPolicy, p, p.Status.Ancestors)
+	}
+	for _, p := range translated.EnvoyExtensionPolicies {
+		reportAncestors(resource.KindEnvoyExtensionPolicy, p, p.Status.Ancestors)
+	}
+	for _, p := range translated.BackendTLSPolicies {
+		reportAncestors(resource.KindBackendTLSPolicy, p, p.Status.Ancestors)
+	}
+
+	return errs, nil
+}
+
+// isProblemCondition returns whether the condition reports a problem with the resource.
+func isProblemCondition(c metav1.Condition) bool {
+	switch gwapiv1.ListenerConditionType(c.Type) {
+	case gwapiv1.ListenerConditionConflicted:
+		return c.Status == metav1.ConditionTrue
+	}
+	return c.Status == metav1.ConditionFalse
+}
+
+func parentRefString(ref gwapiv1.ParentReference, defaultNamespace string) string {
+	namespace := defaultNamespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+	s := namespace + "/" + string(ref.Name)
+	if ref.SectionName != nil {
+		s += " sectionName=" + string(*ref.SectionName)
+	}
+	return s
+}
@@ -67,6 +67,12 @@ spec:
 ...
 local validation error: Backend.gateway.envoyproxy.io "backend-2" is invalid: spec.endpoints: Invalid value: "array": fqdn addresses cannot be mixed with other address types
 
+`,
+		},
+		{
+			name: "semantic-errors",
+			output: `HTTPRoute default/invalid-regex parentRef default/eg: Accepted=False (InvalidRegex) Regex "/foo[a-z" is invalid: error parsing regexp: missing closing ]: ` + "`[a-z`." + `
+HTTPRoute default/unknown-section parentRef default/eg sectionName=https: Accepted=False (NoMatchingParent) No listeners match this parent ref
 `,
 		},
 	}
@@ -76,7 +82,7 @@ local validation error: Backend.gateway.envoyproxy.io "backend-2" is invalid: sp
 			b := bytes.NewBufferString("")
 			root := newValidateCommand()
 			root.SetOut(b)
-			root.SetErr(b)
+			root.SetErr(io.Discard)
 			args := []string{
 				"--file",
 				path.Join("testdata", "validate", tc.name+".yaml"),
@@ -84,7 +90,7 @@ local validation error: Backend.gateway.envoyproxy.io "backend-2" is invalid: sp
 
 			root.SetArgs(args)
 			err := root.ExecuteContext(context.Background())
-			require.NoError(t, err)
+			require.EqualError(t, err, "validation failed")
 
 			out, err := io.ReadAll(b)
 			require.NoError(t, err)
@@ -190,8 +190,9 @@ func setupRunners(ctx context.Context, cfg *config.Server) (err error) {
 	// It subscribes to the infraIR, translates it into Envoy Proxy infrastructure
 	// resources such as K8s deployment and services.
 	infraRunner := infrarunner.New(&infrarunner.Config{
-		Server:  *cfg,
-		InfraIR: infraIR,
+		Server:           *cfg,
+		InfraIR:          infraIR,
+		ExtensionManager: extMgr,
 	})
 	if err = infraRunner.Start(ctx); err != nil {
 		return err
@@ -233,7 +234,7 @@ func setupRunners(ctx context.Context, cfg *config.Server) (err error) {
 
 	if extMgr != nil {
 		// Close connections to extension services
-		if mgr, ok := extMgr.(*extensionregistry.Manager); ok {
+		if mgr, ok := extMgr.(interface{ CleanupHookConns() }); ok {
 			mgr.CleanupHookConns()
 		}
 	}
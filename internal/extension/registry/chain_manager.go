@@ -0,0 +1,255 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"fmt"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
+)
+
+var (
+	_ extTypes.Manager         = (*chainManager)(nil)
+	_ extTypes.XDSHookClient   = (*chainXDSHook)(nil)
+	_ extTypes.InfraHookClient = (*chainInfraHook)(nil)
+)
+
+// chainManager handles multiple registered extensions. The hooks of every extension
+// are executed in the order the extensions were registered, with the resources
+// returned by one extension passed as the input to the next.
+type chainManager struct {
+	managers []*Manager
+}
+
+func newChainManager(managers []*Manager) *chainManager {
+	return &chainManager{managers: managers}
+}
+
+// FailOpen returns true only if all the registered extensions are configured to fail open.
+// Errors returned by an extension configured to fail open are ignored by the chain itself.
+func (c *chainManager) FailOpen() bool {
+	for _, m := range c.managers {
+		if !m.FailOpen() {
+			return false
+		}
+	}
+	return true
+}
+
+// HasExtension checks to see whether any of the registered extensions handles the given Group and Kind.
+func (c *chainManager) HasExtension(g gwapiv1.Group, k gwapiv1.Kind) bool {
+	for _, m := range c.managers {
+		if m.HasExtension(g, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPreXDSHookClient returns a client chaining the pre xDS hooks of all the extensions that make use of
+// the hook type, or nil if none of them does.
+func (c *chainManager) GetPreXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (extTypes.XDSHookClient, error) {
+	return c.getXDSHookClient(xdsHookType, (*Manager).GetPreXDSHookClient)
+}
+
+// GetPostXDSHookClient returns a client chaining the post xDS hooks of all the extensions that make use of
+// the hook type, or nil if none of them does.
+func (c *chainManager) GetPostXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (extTypes.XDSHookClient, error) {
+	return c.getXDSHookClient(xdsHookType, (*Manager).GetPostXDSHookClient)
+}
+
+func (c *chainManager) getXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook,
+	getClient func(*Manager, egv1a1.XDSTranslatorHook) (extTypes.XDSHookClient, error),
+) (extTypes.XDSHookClient, error) {
+	var hooks []chainedHook
+	for _, m := range c.managers {
+		client, err := getClient(m, xdsHookType)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %w", m.extension.Name, err)
+		}
+		if client == nil {
+			continue
+		}
+		hooks = append(hooks, chainedHook{
+			client:    client,
+			extension: &m.extension,
+		})
+	}
+
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+	return &chainXDSHook{hooks: hooks}, nil
+}
+
+// GetPostInfraHookClient returns a client chaining the post infrastructure hooks of all the extensions that make
+// use of the hook type, or nil if none of them does.
+func (c *chainManager) GetPostInfraHookClient(infraHookType egv1a1.InfrastructureHook) (extTypes.InfraHookClient, error) {
+	var hooks []chainedInfraHook
+	for _, m := range c.managers {
+		client, err := m.GetPostInfraHookClient(infraHookType)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %w", m.extension.Name, err)
+		}
+		if client == nil {
+			continue
+		}
+		hooks = append(hooks, chainedInfraHook{
+			client:    client,
+			extension: &m.extension,
+		})
+	}
+
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+	return &chainInfraHook{hooks: hooks}, nil
+}
+
+// CleanupHookConns closes the connections to all the registered extensions.
+func (c *chainManager) CleanupHookConns() {
+	for _, m := range c.managers {
+		m.CleanupHookConns()
+	}
+}
+
+type chainedHook struct {
+	client    extTypes.XDSHookClient
+	extension *egv1a1.ExtensionManager
+}
+
+// chainXDSHook calls the hooks of multiple extensions in order.
+type chainXDSHook struct {
+	hooks []chainedHook
+}
+
+func (c *chainXDSHook) PostRouteModifyHook(r *route.Route, routeHostnames []string, extensionResources []*unstructured.Unstructured) (*route.Route, error) {
+	var modified *route.Route
+	for _, hook := range c.hooks {
+		// Only pass the extension the resources it registered for, and skip it if the route
+		// doesn't use any of them.
+		resources := filterExtensionResources(extensionResources, hook.extension.Resources)
+		if len(resources) == 0 {
+			continue
+		}
+
+		out, err := hook.client.PostRouteModifyHook(r, routeHostnames, resources)
+		if err != nil {
+			if hook.extension.FailOpen {
+				continue
+			}
+			return nil, fmt.Errorf("extension %s: %w", hook.extension.Name, err)
+		}
+		if out != nil {
+			r = out
+			modified = out
+		}
+	}
+	return modified, nil
+}
+
+func (c *chainXDSHook) PostVirtualHostModifyHook(vh *route.VirtualHost) (*route.VirtualHost, error) {
+	var modified *route.VirtualHost
+	for _, hook := range c.hooks {
+		out, err := hook.client.PostVirtualHostModifyHook(vh)
+		if err != nil {
+			if hook.extension.FailOpen {
+				continue
+			}
+			return nil, fmt.Errorf("extension %s: %w", hook.extension.Name, err)
+		}
+		if out != nil {
+			vh = out
+			modified = out
+		}
+	}
+	return modified, nil
+}
+
+func (c *chainXDSHook) PostHTTPListenerModifyHook(l *listener.Listener, extensionResources []*unstructured.Unstructured) (*listener.Listener, error) {
+	var modified *listener.Listener
+	for _, hook := range c.hooks {
+		resources := filterExtensionResources(extensionResources, hook.extension.PolicyResources)
+		out, err := hook.client.PostHTTPListenerModifyHook(l, resources)
+		if err != nil {
+			if hook.extension.FailOpen {
+				continue
+			}
+			return nil, fmt.Errorf("extension %s: %w", hook.extension.Name, err)
+		}
+		if out != nil {
+			l = out
+			modified = out
+		}
+	}
+	return modified, nil
+}
+
+func (c *chainXDSHook) PostTranslateModifyHook(clusters []*cluster.Cluster, secrets []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error) {
+	for _, hook := range c.hooks {
+		outClusters, outSecrets, err := hook.client.PostTranslateModifyHook(clusters, secrets)
+		if err != nil {
+			if hook.extension.FailOpen {
+				continue
+			}
+			return nil, nil, fmt.Errorf("extension %s: %w", hook.extension.Name, err)
+		}
+		clusters, secrets = outClusters, outSecrets
+	}
+	return clusters, secrets, nil
+}
+
+type chainedInfraHook struct {
+	client    extTypes.InfraHookClient
+	extension *egv1a1.ExtensionManager
+}
+
+// chainInfraHook calls the infrastructure hooks of multiple extensions in order.
+type chainInfraHook struct {
+	hooks []chainedInfraHook
+}
+
+func (c *chainInfraHook) PostInfraModifyHook(resource *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var modified *unstructured.Unstructured
+	for _, hook := range c.hooks {
+		out, err := hook.client.PostInfraModifyHook(resource)
+		if err != nil {
+			if hook.extension.FailOpen {
+				continue
+			}
+			return nil, fmt.Errorf("extension %s: %w", hook.extension.Name, err)
+		}
+		if out != nil {
+			resource = out
+			modified = out
+		}
+	}
+	return modified, nil
+}
+
+// filterExtensionResources returns the resources matching one of the given kinds.
+func filterExtensionResources(resources []*unstructured.Unstructured, gvks []egv1a1.GroupVersionKind) []*unstructured.Unstructured {
+	var filtered []*unstructured.Unstructured
+	for _, resource := range resources {
+		gvk := resource.GroupVersionKind()
+		for _, kind := range gvks {
+			// TODO: not currently checking the version since extensionRef only supports group and kind.
+			if gvk.Group == kind.Group && gvk.Kind == kind.Kind {
+				filtered = append(filtered, resource)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,137 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+)
+
+// fakeXDSHook appends its name to the route name and records the resources it was called with.
+type fakeXDSHook struct {
+	name      string
+	err       error
+	resources []*unstructured.Unstructured
+}
+
+func (f *fakeXDSHook) PostRouteModifyHook(r *route.Route, _ []string, resources []*unstructured.Unstructured) (*route.Route, error) {
+	f.resources = resources
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &route.Route{Name: r.Name + "-" + f.name}, nil
+}
+
+func (f *fakeXDSHook) PostVirtualHostModifyHook(vh *route.VirtualHost) (*route.VirtualHost, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &route.VirtualHost{Name: vh.Name + "-" + f.name}, nil
+}
+
+func (f *fakeXDSHook) PostHTTPListenerModifyHook(l *listener.Listener, resources []*unstructured.Unstructured) (*listener.Listener, error) {
+	f.resources = resources
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &listener.Listener{Name: l.Name + "-" + f.name}, nil
+}
+
+func (f *fakeXDSHook) PostTranslateModifyHook(clusters []*cluster.Cluster, secrets []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error) {
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return append(clusters, &cluster.Cluster{Name: f.name}), secrets, nil
+}
+
+func newUnstructured(group, kind, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(group + "/v1alpha1")
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func TestChainXDSHook(t *testing.T) {
+	security := &fakeXDSHook{name: "security"}
+	routing := &fakeXDSHook{name: "routing"}
+	chain := &chainXDSHook{
+		hooks: []chainedHook{
+			{
+				client: security,
+				extension: &egv1a1.ExtensionManager{
+					Name:            "security",
+					Resources:       []egv1a1.GroupVersionKind{{Group: "security.example.io", Version: "v1alpha1", Kind: "Auth"}},
+					PolicyResources: []egv1a1.GroupVersionKind{{Group: "security.example.io", Version: "v1alpha1", Kind: "AuthPolicy"}},
+				},
+			},
+			{
+				client: routing,
+				extension: &egv1a1.ExtensionManager{
+					Name:      "routing",
+					Resources: []egv1a1.GroupVersionKind{{Group: "routing.example.io", Version: "v1alpha1", Kind: "Mirror"}},
+				},
+			},
+		},
+	}
+
+	auth := newUnstructured("security.example.io", "Auth", "auth")
+	mirror := newUnstructured("routing.example.io", "Mirror", "mirror")
+	authPolicy := newUnstructured("security.example.io", "AuthPolicy", "policy")
+
+	t.Run("route hooks are executed in order with filtered resources", func(t *testing.T) {
+		r, err := chain.PostRouteModifyHook(&route.Route{Name: "route"}, nil, []*unstructured.Unstructured{auth, mirror})
+		require.NoError(t, err)
+		require.Equal(t, "route-security-routing", r.Name)
+		require.Equal(t, []*unstructured.Unstructured{auth}, security.resources)
+		require.Equal(t, []*unstructured.Unstructured{mirror}, routing.resources)
+	})
+
+	t.Run("route hook is skipped when the route has no resources of the extension", func(t *testing.T) {
+		r, err := chain.PostRouteModifyHook(&route.Route{Name: "route"}, nil, []*unstructured.Unstructured{mirror})
+		require.NoError(t, err)
+		require.Equal(t, "route-routing", r.Name)
+	})
+
+	t.Run("listener hooks only receive their policy resources", func(t *testing.T) {
+		l, err := chain.PostHTTPListenerModifyHook(&listener.Listener{Name: "listener"}, []*unstructured.Unstructured{authPolicy})
+		require.NoError(t, err)
+		require.Equal(t, "listener-security-routing", l.Name)
+		require.Equal(t, []*unstructured.Unstructured{authPolicy}, security.resources)
+		require.Empty(t, routing.resources)
+	})
+
+	t.Run("translate hooks receive the output of the previous extension", func(t *testing.T) {
+		clusters, _, err := chain.PostTranslateModifyHook(nil, nil)
+		require.NoError(t, err)
+		require.Len(t, clusters, 2)
+		require.Equal(t, "security", clusters[0].Name)
+		require.Equal(t, "routing", clusters[1].Name)
+	})
+
+	t.Run("fail open extension errors are ignored", func(t *testing.T) {
+		security.err = errors.New("unavailable")
+		chain.hooks[0].extension.FailOpen = true
+		vh, err := chain.PostVirtualHostModifyHook(&route.VirtualHost{Name: "vh"})
+		require.NoError(t, err)
+		require.Equal(t, "vh-routing", vh.Name)
+	})
+
+	t.Run("fail closed extension errors are returned", func(t *testing.T) {
+		chain.hooks[0].extension.FailOpen = false
+		_, err := chain.PostVirtualHostModifyHook(&route.VirtualHost{Name: "vh"})
+		require.ErrorContains(t, err, "extension security")
+	})
+}
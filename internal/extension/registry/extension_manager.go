@@ -60,14 +60,29 @@ func NewManager(cfg *config.Server) (extTypes.Manager, error) {
 		return nil, err
 	}
 
-	var extension *egv1a1.ExtensionManager
+	var extensions []*egv1a1.ExtensionManager
 	if cfg.EnvoyGateway != nil {
-		extension = cfg.EnvoyGateway.ExtensionManager
+		extensions = cfg.EnvoyGateway.GetExtensionManagers()
+	}
+
+	// Chain the extensions together when more than one is registered, hooks are
+	// executed in the order the extensions are returned.
+	if len(extensions) > 1 {
+		managers := make([]*Manager, len(extensions))
+		for i, extension := range extensions {
+			managers[i] = &Manager{
+				k8sClient: cli,
+				namespace: cfg.Namespace,
+				extension: *extension,
+			}
+		}
+		return newChainManager(managers), nil
 	}
 
 	// Setup an empty default in the case that no config was provided
-	if extension == nil {
-		extension = &egv1a1.ExtensionManager{}
+	extension := &egv1a1.ExtensionManager{}
+	if len(extensions) == 1 {
+		extension = extensions[0]
 	}
 
 	return &Manager{
@@ -167,23 +182,11 @@ func (m *Manager) GetPreXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (ext
 		return nil, nil
 	}
 
-	if m.extensionConnCache == nil {
-		serverAddr := getExtensionServerAddress(ext.Service)
-
-		opts, err := setupGRPCOpts(ctx, m.k8sClient, &ext, m.namespace)
-		if err != nil {
-			return nil, err
-		}
-
-		conn, err := grpc.Dial(serverAddr, opts...)
-		if err != nil {
-			return nil, err
-		}
-
-		m.extensionConnCache = conn
+	client, err := m.grpcClient(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	client := extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache)
 	xdsHookClient := &XDSHook{
 		grpcClient: client,
 	}
@@ -216,7 +219,59 @@ func (m *Manager) GetPostXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (ex
 		return nil, nil
 	}
 
+	client, err := m.grpcClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	xdsHookClient := &XDSHook{
+		grpcClient: client,
+	}
+	return xdsHookClient, nil
+}
+
+// GetPostInfraHookClient checks if the registered extension makes use of a particular hook type that modifies
+// the infrastructure resources after they are generated by Envoy Gateway.
+// If the extension makes use of the hook then the Infra Hook Client is returned. If it does not support
+// the hook type then nil is returned
+func (m *Manager) GetPostInfraHookClient(infraHookType egv1a1.InfrastructureHook) (extTypes.InfraHookClient, error) {
+	ctx := context.Background()
+	ext := m.extension
+
+	if ext.Hooks == nil {
+		return nil, nil
+	}
+	if ext.Hooks.Infrastructure == nil {
+		return nil, nil
+	}
+
+	hookUsed := false
+	for _, hook := range ext.Hooks.Infrastructure.Post {
+		if infraHookType == hook {
+			hookUsed = true
+			break
+		}
+	}
+	if !hookUsed {
+		return nil, nil
+	}
+
+	client, err := m.grpcClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infraHookClient := &InfraHook{
+		grpcClient: client,
+	}
+	return infraHookClient, nil
+}
+
+// grpcClient returns a client for the extension service, establishing and caching
+// the connection to the service if needed.
+func (m *Manager) grpcClient(ctx context.Context) (extension.EnvoyGatewayExtensionClient, error) {
 	if m.extensionConnCache == nil {
+		ext := m.extension
 		serverAddr := getExtensionServerAddress(ext.Service)
 
 		opts, err := setupGRPCOpts(ctx, m.k8sClient, &ext, m.namespace)
@@ -232,11 +287,7 @@ func (m *Manager) GetPostXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (ex
 		m.extensionConnCache = conn
 	}
 
-	client := extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache)
-	xdsHookClient := &XDSHook{
-		grpcClient: client,
-	}
-	return xdsHookClient, nil
+	return extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache), nil
 }
 
 func (m *Manager) CleanupHookConns() {
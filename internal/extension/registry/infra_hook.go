@@ -0,0 +1,51 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/envoyproxy/gateway/internal/extension/types"
+	"github.com/envoyproxy/gateway/proto/extension"
+)
+
+var _ types.InfraHookClient = (*InfraHook)(nil)
+
+type InfraHook struct {
+	grpcClient extension.EnvoyGatewayExtensionClient
+}
+
+func (h *InfraHook) PostInfraModifyHook(resource *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	resourceBytes, err := resource.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request to the extension server
+	ctx := context.Background()
+	resp, err := h.grpcClient.PostInfraModify(ctx,
+		&extension.PostInfraModifyRequest{
+			Resource: &extension.ExtensionResource{
+				UnstructuredBytes: resourceBytes,
+			},
+			PostInfraContext: &extension.PostInfraExtensionContext{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Resource == nil || len(resp.Resource.UnstructuredBytes) == 0 {
+		return nil, nil
+	}
+
+	modified := &unstructured.Unstructured{}
+	if err := modified.UnmarshalJSON(resp.Resource.UnstructuredBytes); err != nil {
+		return nil, err
+	}
+	return modified, nil
+}
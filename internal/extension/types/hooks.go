@@ -43,3 +43,11 @@ type XDSHookClient interface {
 	// PostTranslateModifyHook is always executed when an extension is loaded
 	PostTranslateModifyHook([]*cluster.Cluster, []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error)
 }
+
+type InfraHookClient interface {
+	// PostInfraModifyHook allows an extension to modify a Kubernetes resource (e.g. Deployment, Service, HorizontalPodAutoscaler)
+	// generated by Envoy Gateway for an Envoy Proxy fleet before it is applied.
+	// PostInfraModifyHook will only be executed if an extension is loaded with the Infrastructure Resources post hook.
+	// An extension may return nil in order to not make any changes to it.
+	PostInfraModifyHook(resource *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
@@ -33,6 +33,12 @@ type Manager interface {
 	// the hook type then nil is returned
 	GetPostXDSHookClient(xdsHookType egv1a1.XDSTranslatorHook) (XDSHookClient, error)
 
+	// GetPostInfraHookClient checks if the registered extension makes use of a particular hook type that modifies
+	// the infrastructure resources after they are generated by Envoy Gateway.
+	// If the extension makes use of the hook then the Infra Hook Client is returned. If it does not support
+	// the hook type then nil is returned
+	GetPostInfraHookClient(infraHookType egv1a1.InfrastructureHook) (InfraHookClient, error)
+
 	// FailOpen returns true if the extension manager is configured to fail open, and false otherwise.
 	FailOpen() bool
 }
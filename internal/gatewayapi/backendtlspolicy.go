@@ -163,6 +163,7 @@ func getBackendTLSBundle(backendTLSPolicy *gwapiv1a3.BackendTLSPolicy, resources
 	tlsBundle := &ir.TLSUpstreamConfig{
 		SNI:                 string(backendTLSPolicy.Spec.Validation.Hostname),
 		UseSystemTrustStore: ptr.Deref(backendTLSPolicy.Spec.Validation.WellKnownCACertificates, "") == gwapiv1a3.WellKnownCACertificatesSystem,
+		SubjectAltNames:     irSubjectAltNames(backendTLSPolicy.Spec.Validation.SubjectAltNames),
 	}
 	if tlsBundle.UseSystemTrustStore {
 		tlsBundle.CACertificate = &ir.TLSCACertificate{
@@ -216,6 +217,19 @@ func getBackendTLSBundle(backendTLSPolicy *gwapiv1a3.BackendTLSPolicy, resources
 	return tlsBundle, nil
 }
 
+func irSubjectAltNames(sans []gwapiv1a3.SubjectAltName) []ir.SubjectAltName {
+	var irSANs []ir.SubjectAltName
+	for _, san := range sans {
+		switch san.Type {
+		case gwapiv1a3.HostnameSubjectAltNameType:
+			irSANs = append(irSANs, ir.SubjectAltName{Hostname: ptr.To(string(san.Hostname))})
+		case gwapiv1a3.URISubjectAltNameType:
+			irSANs = append(irSANs, ir.SubjectAltName{URI: ptr.To(string(san.URI))})
+		}
+	}
+	return irSANs
+}
+
 func getAncestorRefs(policy *gwapiv1a3.BackendTLSPolicy) []gwapiv1a2.ParentReference {
 	ret := make([]gwapiv1a2.ParentReference, len(policy.Status.Ancestors))
 	for i, ancestor := range policy.Status.Ancestors {
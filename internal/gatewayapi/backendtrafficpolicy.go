@@ -6,18 +6,24 @@
 package gatewayapi
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	perr "github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -68,80 +74,165 @@ func (t *Translator) ProcessBackendTrafficPolicies(resources *resource.Resources
 
 	handledPolicies := make(map[types.NamespacedName]*egv1a1.BackendTrafficPolicy)
 
-	// Translate
-	// 1. First translate Policies targeting xRoutes
-	// 2. Finally, the policies targeting Gateways
-
-	// Process the policies targeting xRoutes
+	// Map of Gateway to the policy attached to it, which is merged with the
+	// policies targeting its xRoutes when they set a mergeType
+	gatewayPolicyMap := make(map[types.NamespacedName]*egv1a1.BackendTrafficPolicy)
 	for _, currPolicy := range backendTrafficPolicies {
-		policyName := utils.NamespacedName(currPolicy)
-		targetRefs := getPolicyTargetRefs(currPolicy.Spec.PolicyTargetReferences, routes)
+		targetRefs := getPolicyTargetRefs(currPolicy.Spec.PolicyTargetReferences, gateways)
 		for _, currTarget := range targetRefs {
-			if currTarget.Kind != resource.KindGateway {
-				policy, found := handledPolicies[policyName]
-				if !found {
-					policy = currPolicy.DeepCopy()
-					handledPolicies[policyName] = policy
-					res = append(res, policy)
+			if currTarget.Kind == resource.KindGateway {
+				key := types.NamespacedName{
+					Name:      string(currTarget.Name),
+					Namespace: currPolicy.Namespace,
 				}
-
-				// Negative statuses have already been assigned so its safe to skip
-				route, resolveErr := resolveBTPolicyRouteTargetRef(policy, currTarget, routeMap)
-				if route == nil {
-					continue
+				// Only the oldest policy attaches to the Gateway, the others are conflicted
+				if _, ok := gatewayMap[key]; ok && gatewayPolicyMap[key] == nil {
+					gatewayPolicyMap[key] = currPolicy
 				}
+			}
+		}
+	}
 
-				// Find the Gateway that the route belongs to and add it to the
-				// gatewayRouteMap and ancestor list, which will be used to check
-				// policy overrides and populate its ancestor status.
-				parentRefs := GetParentReferences(route)
-				ancestorRefs := make([]gwapiv1a2.ParentReference, 0, len(parentRefs))
-				for _, p := range parentRefs {
-					if p.Kind == nil || *p.Kind == resource.KindGateway {
-						namespace := route.GetNamespace()
-						if p.Namespace != nil {
-							namespace = string(*p.Namespace)
-						}
-						gwNN := types.NamespacedName{
-							Namespace: namespace,
-							Name:      string(p.Name),
-						}
+	// Translate
+	// 1. First translate Policies targeting route rules
+	// 2. Then the policies targeting whole xRoutes, which skip the route rules
+	//    that are already configured
+	// 3. Finally, the policies targeting Gateways
+
+	// Process the policies targeting route rules, then the policies targeting xRoutes
+	for _, targetRouteRules := range []bool{true, false} {
+		for _, currPolicy := range backendTrafficPolicies {
+			policyName := utils.NamespacedName(currPolicy)
+			targetRefs := getPolicyTargetRefs(currPolicy.Spec.PolicyTargetReferences, routes)
+			for _, currTarget := range targetRefs {
+				if currTarget.Kind != resource.KindGateway && (currTarget.SectionName != nil) == targetRouteRules {
+					policy, found := handledPolicies[policyName]
+					if !found {
+						policy = currPolicy.DeepCopy()
+						handledPolicies[policyName] = policy
+						res = append(res, policy)
+					}
 
-						key := gwNN.String()
-						if _, ok := gatewayRouteMap[key]; !ok {
-							gatewayRouteMap[key] = make(sets.Set[string])
+					// Negative statuses have already been assigned so its safe to skip
+					route, resolveErr := resolveBTPolicyRouteTargetRef(policy, currTarget, routeMap)
+					if route == nil {
+						continue
+					}
+
+					// Find the Gateway that the route belongs to and add it to the
+					// gatewayRouteMap and ancestor list, which will be used to check
+					// policy overrides and populate its ancestor status.
+					parentRefs := GetParentReferences(route)
+					ancestorRefs := make([]gwapiv1a2.ParentReference, 0, len(parentRefs))
+					parentGateways := make([]types.NamespacedName, 0, len(parentRefs))
+					gatewayAncestorRefs := make(map[types.NamespacedName][]gwapiv1a2.ParentReference)
+					for _, p := range parentRefs {
+						if p.Kind == nil || *p.Kind == resource.KindGateway {
+							namespace := route.GetNamespace()
+							if p.Namespace != nil {
+								namespace = string(*p.Namespace)
+							}
+							gwNN := types.NamespacedName{
+								Namespace: namespace,
+								Name:      string(p.Name),
+							}
+
+							key := gwNN.String()
+							if _, ok := gatewayRouteMap[key]; !ok {
+								gatewayRouteMap[key] = make(sets.Set[string])
+							}
+							gatewayRouteMap[key].Insert(utils.NamespacedName(route).String())
+
+							// Do need a section name since the policy is targeting to a route
+							ancestorRef := getAncestorRefForPolicy(gwNN, p.SectionName)
+							ancestorRefs = append(ancestorRefs, ancestorRef)
+							if _, ok := gatewayAncestorRefs[gwNN]; !ok {
+								parentGateways = append(parentGateways, gwNN)
+							}
+							gatewayAncestorRefs[gwNN] = append(gatewayAncestorRefs[gwNN], ancestorRef)
 						}
-						gatewayRouteMap[key].Insert(utils.NamespacedName(route).String())
+					}
 
-						// Do need a section name since the policy is targeting to a route
-						ancestorRefs = append(ancestorRefs, getAncestorRefForPolicy(gwNN, p.SectionName))
+					// Set conditions for resolve error, then skip current xroute
+					if resolveErr != nil {
+						status.SetResolveErrorForPolicyAncestors(&policy.Status,
+							ancestorRefs,
+							t.GatewayControllerName,
+							policy.Generation,
+							resolveErr,
+						)
+
+						continue
 					}
-				}
 
-				// Set conditions for resolve error, then skip current xroute
-				if resolveErr != nil {
-					status.SetResolveErrorForPolicyAncestors(&policy.Status,
-						ancestorRefs,
-						t.GatewayControllerName,
-						policy.Generation,
-						resolveErr,
+					// Translate the policy, merged with the policies targeting the parent
+					// Gateways if it sets a mergeType
+					var (
+						mergedPolicies map[types.NamespacedName]*egv1a1.BackendTrafficPolicy
+						err            error
 					)
+					if policy.Spec.MergeType == nil {
+						err = t.translateBackendTrafficPolicyForRoute(policy, policy.Namespace, route, currTarget.SectionName, nil, xdsIR, resources)
+					} else {
+						mergedPolicies, err = t.translateBackendTrafficPolicyForRouteWithMerge(policy, route, currTarget.SectionName,
+							parentGateways, gatewayPolicyMap, xdsIR, resources)
+					}
 
-					continue
-				}
+					// Set conditions for translation error if it got any
+					if err != nil {
+						status.SetTranslationErrorForPolicyAncestors(&policy.Status,
+							ancestorRefs,
+							t.GatewayControllerName,
+							policy.Generation,
+							status.Error2ConditionMsg(err),
+						)
+					}
 
-				// Set conditions for translation error if it got any
-				if err := t.translateBackendTrafficPolicyForRoute(policy, route, xdsIR, resources); err != nil {
-					status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-						ancestorRefs,
-						t.GatewayControllerName,
-						policy.Generation,
-						status.Error2ConditionMsg(err),
-					)
-				}
+					// Set Accepted condition if it is unset
+					status.SetAcceptedForPolicyAncestors(&policy.Status, ancestorRefs, t.GatewayControllerName)
+
+					// Report the policies this policy has been merged with
+					for _, gwNN := range parentGateways {
+						if gwPolicy, ok := mergedPolicies[gwNN]; ok {
+							inherited, merged := mergedBackendTrafficPolicyFields(policy, gwPolicy)
+							message := fmt.Sprintf("Merged with BackendTrafficPolicy %s, inheriting the fields %v and merging the fields %v",
+								utils.NamespacedName(gwPolicy).String(), inherited, merged)
+
+							status.SetConditionForPolicyAncestors(&policy.Status,
+								gatewayAncestorRefs[gwNN],
+								t.GatewayControllerName,
+								egv1a1.PolicyConditionMerged,
+								metav1.ConditionTrue,
+								egv1a1.PolicyReasonMerged,
+								message,
+								policy.Generation,
+							)
+						}
+					}
 
-				// Set Accepted condition if it is unset
-				status.SetAcceptedForPolicyAncestors(&policy.Status, ancestorRefs, t.GatewayControllerName)
+					// Check if this policy is overridden by other policies targeting at
+					// route rule level
+					if currTarget.SectionName == nil {
+						key := policyTargetRouteKey{
+							Kind:      string(currTarget.Kind),
+							Name:      string(currTarget.Name),
+							Namespace: policy.Namespace,
+						}
+						if rules := routeMap[key].attachedToRouteRules; rules.Len() > 0 {
+							message := fmt.Sprintf("This policy is being overridden by other backendTrafficPolicies for these route rules: %v", sets.List(rules))
+
+							status.SetConditionForPolicyAncestors(&policy.Status,
+								ancestorRefs,
+								t.GatewayControllerName,
+								egv1a1.PolicyConditionOverridden,
+								metav1.ConditionTrue,
+								egv1a1.PolicyReasonOverridden,
+								message,
+								policy.Generation,
+							)
+						}
+					}
+				}
 			}
 		}
 	}
@@ -267,6 +358,11 @@ func resolveBTPolicyRouteTargetRef(policy *egv1a1.BackendTrafficPolicy, target g
 		return nil, nil
 	}
 
+	// Check if the route rule exists and if another policy targeting the same rule exists
+	if target.SectionName != nil {
+		return route.RouteContext, resolveRouteRuleTargetRef(resource.KindBackendTrafficPolicy, route, *target.SectionName)
+	}
+
 	// Check if another policy targeting the same xRoute exists
 	if route.attached {
 		message := fmt.Sprintf("Unable to target %s %s, another BackendTrafficPolicy has already attached to it",
@@ -285,9 +381,16 @@ func resolveBTPolicyRouteTargetRef(policy *egv1a1.BackendTrafficPolicy, target g
 	return route.RouteContext, nil
 }
 
+// translateBackendTrafficPolicyForRoute applies the policy to the IR routes built
+// from the route, or from its rule named sectionName, within the IR listeners of
+// gateway if it is set. The ConfigMaps referenced by the responseOverride are
+// looked up in refNamespace.
 func (t *Translator) translateBackendTrafficPolicyForRoute(
 	policy *egv1a1.BackendTrafficPolicy,
+	refNamespace string,
 	route RouteContext,
+	sectionName *gwapiv1.SectionName,
+	gateway *types.NamespacedName,
 	xdsIR resource.XdsIRMap,
 	resources *resource.Resources,
 ) error {
@@ -306,6 +409,10 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		h2        *ir.HTTP2Settings
 		ro        *ir.ResponseOverride
 		cp        []*ir.Compression
+		rc        *ir.ResponseCache
+		hu        []ir.HTTPUpgradeConfig
+		tn        *ir.TCPTunneling
+		tr        *ir.RouteTracing
 		err, errs error
 	)
 
@@ -354,19 +461,32 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		errs = errors.Join(errs, err)
 	}
 
-	if ro, err = buildResponseOverride(policy, resources); err != nil {
+	if ro, err = buildResponseOverride(policy, refNamespace, resources); err != nil {
 		err = perr.WithMessage(err, "ResponseOverride")
 		errs = errors.Join(errs, err)
 	}
-	cp = buildCompression(policy.Spec.Compression)
+	cp = buildCompression(policy)
+	if rc, err = buildResponseCache(policy); err != nil {
+		err = perr.WithMessage(err, "ResponseCache")
+		errs = errors.Join(errs, err)
+	}
+	if hu, err = buildHTTPUpgrade(policy); err != nil {
+		err = perr.WithMessage(err, "HTTPUpgrade")
+		errs = errors.Join(errs, err)
+	}
 
 	ds = translateDNS(policy.Spec.ClusterSettings)
+	tn = buildTCPTunneling(policy)
+	tr = buildRouteTracing(policy)
 
 	// Apply IR to all relevant routes
 	prefix := irRoutePrefix(route)
 
 	for _, x := range xdsIR {
 		for _, tcp := range x.TCP {
+			if !irListenerBelongsToGateway(tcp.Name, gateway) {
+				continue
+			}
 			for _, r := range tcp.Routes {
 				if strings.HasPrefix(r.Destination.Name, prefix) {
 					r.LoadBalancer = lb
@@ -377,12 +497,13 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 					r.Timeout = to
 					r.BackendConnection = bc
 					r.DNS = ds
+					r.Tunneling = tn
 				}
 			}
 		}
 
 		for _, udp := range x.UDP {
-			if udp.Route != nil {
+			if udp.Route != nil && irListenerBelongsToGateway(udp.Name, gateway) {
 				r := udp.Route
 
 				if strings.HasPrefix(r.Destination.Name, prefix) {
@@ -393,9 +514,18 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		}
 
 		for _, http := range x.HTTP {
+			if !irListenerBelongsToGateway(http.Name, gateway) {
+				continue
+			}
 			for _, r := range http.Routes {
 				// Apply if there is a match
-				if strings.HasPrefix(r.Name, prefix) {
+				if strings.HasPrefix(r.Name, prefix) && irRouteMatchesRouteRule(r, sectionName) {
+					// A policy targeting a route rule takes precedence over a
+					// policy targeting the whole xRoute
+					if sectionName == nil && r.Traffic != nil {
+						continue
+					}
+
 					if errs != nil {
 						// Return a 500 direct response
 						r.DirectResponse = &ir.CustomResponse{
@@ -423,6 +553,9 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 						Timeout:           to,
 						ResponseOverride:  ro,
 						Compression:       cp,
+						ResponseCache:     rc,
+						HTTPUpgrade:       hu,
+						Tracing:           tr,
 					}
 
 					// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
@@ -439,6 +572,108 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 	return errs
 }
 
+// translateBackendTrafficPolicyForRouteWithMerge translates the policy for the
+// listeners of each parent Gateway of the route, after merging it with the policy
+// targeting this Gateway if there is one. It returns the policies it has been
+// merged with, by Gateway.
+func (t *Translator) translateBackendTrafficPolicyForRouteWithMerge(
+	policy *egv1a1.BackendTrafficPolicy,
+	route RouteContext,
+	sectionName *gwapiv1.SectionName,
+	parentGateways []types.NamespacedName,
+	gatewayPolicies map[types.NamespacedName]*egv1a1.BackendTrafficPolicy,
+	xdsIR resource.XdsIRMap,
+	resources *resource.Resources,
+) (map[types.NamespacedName]*egv1a1.BackendTrafficPolicy, error) {
+	var errs error
+	mergedPolicies := make(map[types.NamespacedName]*egv1a1.BackendTrafficPolicy)
+	for _, gwNN := range parentGateways {
+		effectivePolicy, refNamespace := policy, policy.Namespace
+		if gwPolicy, ok := gatewayPolicies[gwNN]; ok {
+			merged, err := mergeBackendTrafficPolicy(policy, gwPolicy)
+			if err != nil {
+				errs = errors.Join(errs, perr.WithMessage(err, "MergeType"))
+				continue
+			}
+			effectivePolicy = merged
+			mergedPolicies[gwNN] = gwPolicy
+			// The responseOverride inherited from the Gateway policy references
+			// the ConfigMaps in the namespace of the Gateway policy
+			if len(policy.Spec.ResponseOverride) == 0 {
+				refNamespace = gwPolicy.Namespace
+			}
+		}
+
+		if err := t.translateBackendTrafficPolicyForRoute(effectivePolicy, refNamespace, route, sectionName, &gwNN, xdsIR, resources); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return mergedPolicies, errs
+}
+
+// mergedBackendTrafficPolicyFields returns the sorted fields of the effective policy
+// inherited from the gateway policy, and the ones set by both policies and merged.
+func mergedBackendTrafficPolicyFields(routePolicy, gwPolicy *egv1a1.BackendTrafficPolicy) ([]string, []string) {
+	routeFields, gwFields := specFields(routePolicy.Spec), specFields(gwPolicy.Spec)
+	return sets.List(gwFields.Difference(routeFields)), sets.List(gwFields.Intersection(routeFields))
+}
+
+// specFields returns the fields set in the spec of a policy, except the fields
+// selecting the targets of the policy and the mergeType.
+func specFields(spec egv1a1.BackendTrafficPolicySpec) sets.Set[string] {
+	fields := sets.New[string]()
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return fields
+	}
+	var specMap map[string]any
+	if err := json.Unmarshal(specJSON, &specMap); err != nil {
+		return fields
+	}
+	for field := range specMap {
+		fields.Insert(field)
+	}
+	return fields.Delete("targetRef", "targetRefs", "targetSelectors", "mergeType")
+}
+
+// mergeBackendTrafficPolicy merges the spec of the route policy into the spec of
+// the gateway policy with the merge type of the route policy: the gateway policy
+// provides the defaults, and the fields set by the route policy override them.
+func mergeBackendTrafficPolicy(routePolicy, gwPolicy *egv1a1.BackendTrafficPolicy) (*egv1a1.BackendTrafficPolicy, error) {
+	originalJSON, err := json.Marshal(gwPolicy.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the spec of BackendTrafficPolicy %s: %w", utils.NamespacedName(gwPolicy), err)
+	}
+	patchJSON, err := json.Marshal(routePolicy.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the spec of BackendTrafficPolicy %s: %w", utils.NamespacedName(routePolicy), err)
+	}
+
+	var mergedJSON []byte
+	switch *routePolicy.Spec.MergeType {
+	case egv1a1.StrategicMerge:
+		mergedJSON, err = strategicpatch.StrategicMergePatch(originalJSON, patchJSON, egv1a1.BackendTrafficPolicySpec{})
+	case egv1a1.JSONMerge:
+		mergedJSON, err = jsonpatch.MergePatch(originalJSON, patchJSON)
+	default:
+		return nil, fmt.Errorf("unsupported merge type: %s", *routePolicy.Spec.MergeType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error merging with BackendTrafficPolicy %s: %w", utils.NamespacedName(gwPolicy), err)
+	}
+
+	merged := routePolicy.DeepCopy()
+	merged.Spec = egv1a1.BackendTrafficPolicySpec{}
+	if err := json.Unmarshal(mergedJSON, &merged.Spec); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the merged BackendTrafficPolicy spec: %w", err)
+	}
+	// The merged policy keeps the targets of the route policy
+	merged.Spec.PolicyTargetReferences = routePolicy.Spec.PolicyTargetReferences
+
+	return merged, nil
+}
+
 func (t *Translator) translateBackendTrafficPolicyForGateway(
 	policy *egv1a1.BackendTrafficPolicy,
 	target gwapiv1a2.LocalPolicyTargetReferenceWithSectionName,
@@ -460,6 +695,10 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 		h2        *ir.HTTP2Settings
 		ro        *ir.ResponseOverride
 		cp        []*ir.Compression
+		rc        *ir.ResponseCache
+		hu        []ir.HTTPUpgradeConfig
+		tn        *ir.TCPTunneling
+		tr        *ir.RouteTracing
 		err, errs error
 	)
 
@@ -501,13 +740,23 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 		err = perr.WithMessage(err, "HTTP2")
 		errs = errors.Join(errs, err)
 	}
-	if ro, err = buildResponseOverride(policy, resources); err != nil {
+	if ro, err = buildResponseOverride(policy, policy.Namespace, resources); err != nil {
 		err = perr.WithMessage(err, "ResponseOverride")
 		errs = errors.Join(errs, err)
 	}
-	cp = buildCompression(policy.Spec.Compression)
+	cp = buildCompression(policy)
+	if rc, err = buildResponseCache(policy); err != nil {
+		err = perr.WithMessage(err, "ResponseCache")
+		errs = errors.Join(errs, err)
+	}
+	if hu, err = buildHTTPUpgrade(policy); err != nil {
+		err = perr.WithMessage(err, "HTTPUpgrade")
+		errs = errors.Join(errs, err)
+	}
 
 	ds = translateDNS(policy.Spec.ClusterSettings)
+	tn = buildTCPTunneling(policy)
+	tr = buildRouteTracing(policy)
 
 	// Apply IR to all the routes within the specific Gateway
 	// If the feature is already set, then skip it, since it must be have
@@ -534,6 +783,7 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 			setIfNil(&r.TCPKeepalive, ka)
 			setIfNil(&r.Timeout, ct)
 			setIfNil(&r.DNS, ds)
+			setIfNil(&r.Tunneling, tn)
 		}
 	}
 
@@ -591,6 +841,9 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 				DNS:              ds,
 				ResponseOverride: ro,
 				Compression:      cp,
+				ResponseCache:    rc,
+				HTTPUpgrade:      hu,
+				Tracing:          tr,
 			}
 
 			// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
@@ -737,10 +990,10 @@ func buildRateLimitRule(rule egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 	}
 
 	for _, match := range rule.ClientSelectors {
-		if len(match.Headers) == 0 && match.SourceCIDR == nil {
+		if len(match.Headers) == 0 && match.Path == nil && match.SourceCIDR == nil {
 			return nil, fmt.Errorf(
 				"unable to translate rateLimit. At least one of the" +
-					" header or sourceCIDR must be specified")
+					" header, path or sourceCIDR must be specified")
 		}
 		for _, header := range match.Headers {
 			switch {
@@ -780,6 +1033,14 @@ func buildRateLimitRule(rule egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 			}
 		}
 
+		if match.Path != nil {
+			m, err := buildRateLimitPathMatch(match.Path)
+			if err != nil {
+				return nil, fmt.Errorf("unable to translate rateLimit: %w", err)
+			}
+			irRule.HeaderMatches = append(irRule.HeaderMatches, m)
+		}
+
 		if match.SourceCIDR != nil {
 			// distinct means that each IP Address within the specified Source IP CIDR is treated as a
 			// distinct client selector and uses a separate rate limit bucket/counter.
@@ -809,6 +1070,33 @@ func buildRateLimitRule(rule egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 	return irRule, nil
 }
 
+// buildRateLimitPathMatch matches the path of the request with the :path pseudo-header, which also
+// contains the query string. A regular expression matching an optional query string is appended to
+// the path match, so the query string is ignored.
+func buildRateLimitPathMatch(path *gwapiv1.HTTPPathMatch) (*ir.StringMatch, error) {
+	value := ptr.Deref(path.Value, "/")
+	var pathRegex string
+	switch ptr.Deref(path.Type, gwapiv1.PathMatchPathPrefix) {
+	case gwapiv1.PathMatchExact:
+		pathRegex = regexp.QuoteMeta(value)
+	case gwapiv1.PathMatchPathPrefix:
+		// The prefix is matched on path segments, "/foo" matches "/foo" and "/foo/bar" but not "/foobar".
+		pathRegex = regexp.QuoteMeta(strings.TrimSuffix(value, "/")) + "(/.*)?"
+	case gwapiv1.PathMatchRegularExpression:
+		if err := regex.Validate(value); err != nil {
+			return nil, err
+		}
+		pathRegex = "(?:" + value + ")"
+	default:
+		return nil, fmt.Errorf("unsupported path match type %s", *path.Type)
+	}
+
+	return &ir.StringMatch{
+		Name:      ":path",
+		SafeRegex: ptr.To(pathRegex + `(\?.*)?`),
+	}, nil
+}
+
 func translateRateLimitCost(cost *egv1a1.RateLimitCostSpecifier) *ir.RateLimitCost {
 	ret := &ir.RateLimitCost{}
 	if cost.Number != nil {
@@ -881,7 +1169,7 @@ func makeIrTriggerSet(in []egv1a1.TriggerEnum) []ir.TriggerEnum {
 	return irTriggers
 }
 
-func buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, resources *resource.Resources) (*ir.ResponseOverride, error) {
+func buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, policyNs string, resources *resource.Resources) (*ir.ResponseOverride, error) {
 	if len(policy.Spec.ResponseOverride) == 0 {
 		return nil, nil
 	}
@@ -890,6 +1178,7 @@ func buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, resources *resou
 	for index, ro := range policy.Spec.ResponseOverride {
 		match := ir.CustomResponseMatch{
 			StatusCodes: make([]ir.StatusCodeMatch, 0, len(ro.Match.StatusCodes)),
+			Failures:    ro.Match.Failures,
 		}
 
 		for _, code := range ro.Match.StatusCodes {
@@ -909,6 +1198,7 @@ func buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, resources *resou
 
 		response := ir.CustomResponse{
 			ContentType: ro.Response.ContentType,
+			Templated:   ptr.Deref(ro.Response.Templated, false),
 		}
 
 		if ro.Response.StatusCode != nil {
@@ -916,7 +1206,7 @@ func buildResponseOverride(policy *egv1a1.BackendTrafficPolicy, resources *resou
 		}
 
 		var err error
-		response.Body, err = getCustomResponseBody(ro.Response.Body, resources, policy.Namespace)
+		response.Body, err = getCustomResponseBody(ro.Response.Body, resources, policyNs)
 		if err != nil {
 			return nil, err
 		}
@@ -968,16 +1258,95 @@ func defaultResponseOverrideRuleName(policy *egv1a1.BackendTrafficPolicy, index
 		strconv.Itoa(index))
 }
 
-func buildCompression(compression []*egv1a1.Compression) []*ir.Compression {
+func buildRouteTracing(policy *egv1a1.BackendTrafficPolicy) *ir.RouteTracing {
+	tracing := policy.Spec.Tracing
+	if tracing == nil {
+		return nil
+	}
+
+	return &ir.RouteTracing{
+		Disable:      ptr.Deref(tracing.Disable, false),
+		SamplingRate: tracing.SamplingRate,
+	}
+}
+
+func buildCompression(policy *egv1a1.BackendTrafficPolicy) []*ir.Compression {
+	compression := policy.Spec.Compression
 	if compression == nil {
 		return nil
 	}
 	irCompression := make([]*ir.Compression, 0, len(compression))
 	for _, c := range compression {
 		irCompression = append(irCompression, &ir.Compression{
-			Type: c.Type,
+			Name:             irConfigName(policy),
+			Type:             c.Type,
+			MinContentLength: c.MinContentLength,
+			ContentTypes:     c.ContentTypes,
 		})
 	}
 
 	return irCompression
 }
+
+func buildResponseCache(policy *egv1a1.BackendTrafficPolicy) (*ir.ResponseCache, error) {
+	responseCache := policy.Spec.ResponseCache
+	if responseCache == nil {
+		return nil, nil
+	}
+
+	irResponseCache := &ir.ResponseCache{
+		Name: irConfigName(policy),
+	}
+	if responseCache.Key != nil {
+		irResponseCache.AllowedVaryHeaders = responseCache.Key.Headers
+		irResponseCache.QueryParams = responseCache.Key.QueryParams
+	}
+	if responseCache.TTL != nil {
+		d, err := time.ParseDuration(string(*responseCache.TTL))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TTL value %s", *responseCache.TTL)
+		}
+		// The TTL is set as the max-age of the Cache-Control header, which is in seconds.
+		if d < time.Second {
+			return nil, fmt.Errorf("TTL value %s must be at least 1s", *responseCache.TTL)
+		}
+		irResponseCache.TTL = ptr.To(metav1.Duration{Duration: d})
+	}
+
+	return irResponseCache, nil
+}
+
+func buildTCPTunneling(policy *egv1a1.BackendTrafficPolicy) *ir.TCPTunneling {
+	if policy.Spec.TCPTunneling == nil {
+		return nil
+	}
+
+	return &ir.TCPTunneling{
+		Hostname: policy.Spec.TCPTunneling.Hostname,
+	}
+}
+
+func buildHTTPUpgrade(policy *egv1a1.BackendTrafficPolicy) ([]ir.HTTPUpgradeConfig, error) {
+	if len(policy.Spec.HTTPUpgrade) == 0 {
+		return nil, nil
+	}
+
+	upgrades := make([]ir.HTTPUpgradeConfig, 0, len(policy.Spec.HTTPUpgrade))
+	seen := sets.New[string]()
+	for _, upgrade := range policy.Spec.HTTPUpgrade {
+		upgradeType := strings.ToLower(upgrade.Type)
+		if upgradeType == "connect" || upgradeType == "connect-udp" {
+			return nil, fmt.Errorf("%s upgrade is configured with the CONNECT method match of HTTPRoute rules", upgrade.Type)
+		}
+		if seen.Has(upgradeType) {
+			return nil, fmt.Errorf("duplicated upgrade type %s", upgrade.Type)
+		}
+		seen.Insert(upgradeType)
+		upgrades = append(upgrades, ir.HTTPUpgradeConfig{
+			Type:     upgradeType,
+			Disabled: ptr.Deref(upgrade.Disabled, false),
+		})
+	}
+
+	return upgrades, nil
+}
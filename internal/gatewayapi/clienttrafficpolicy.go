@@ -395,12 +395,13 @@ func (t *Translator) translateClientTrafficPolicyForListener(policy *egv1a1.Clie
 
 	// HTTP and TCP listeners can both be configured by common fields below.
 	var (
-		keepalive           *ir.TCPKeepalive
-		connection          *ir.ClientConnection
-		tlsConfig           *ir.TLSConfig
-		enableProxyProtocol bool
-		timeout             *ir.ClientTimeout
-		err, errs           error
+		keepalive             *ir.TCPKeepalive
+		connection            *ir.ClientConnection
+		tlsConfig             *ir.TLSConfig
+		enableProxyProtocol   bool
+		optionalProxyProtocol bool
+		timeout               *ir.ClientTimeout
+		err, errs             error
 	)
 
 	// Build common IR shared by HTTP and TCP listeners, return early if some field is invalid.
@@ -420,6 +421,10 @@ func (t *Translator) translateClientTrafficPolicyForListener(policy *egv1a1.Clie
 
 	// Translate Proxy Protocol
 	enableProxyProtocol = ptr.Deref(policy.Spec.EnableProxyProtocol, false)
+	if policy.Spec.ProxyProtocol != nil {
+		enableProxyProtocol = true
+		optionalProxyProtocol = ptr.Deref(policy.Spec.ProxyProtocol.Optional, false)
+	}
 
 	// Translate Client Timeout Settings
 	timeout, err = buildClientTimeout(policy.Spec.Timeout)
@@ -475,6 +480,12 @@ func (t *Translator) translateClientTrafficPolicyForListener(policy *egv1a1.Clie
 		// Translate Health Check Settings
 		translateHealthCheckSettings(policy.Spec.HealthCheck, httpIR)
 
+		// Translate IP Tagging Settings
+		if err = translateIPTaggingSettings(policy.Spec.IPTagging, httpIR); err != nil {
+			err = perr.WithMessage(err, "IPTagging")
+			errs = errors.Join(errs, err)
+		}
+
 		// Translate TLS parameters
 		tlsConfig, err = t.buildListenerTLSParameters(policy, httpIR.TLS, resources)
 		if err != nil {
@@ -496,6 +507,7 @@ func (t *Translator) translateClientTrafficPolicyForListener(policy *egv1a1.Clie
 		httpIR.TCPKeepalive = keepalive
 		httpIR.Connection = connection
 		httpIR.EnableProxyProtocol = enableProxyProtocol
+		httpIR.OptionalProxyProtocol = optionalProxyProtocol
 		httpIR.Timeout = timeout
 		httpIR.TLS = tlsConfig
 	}
@@ -519,6 +531,7 @@ func (t *Translator) translateClientTrafficPolicyForListener(policy *egv1a1.Clie
 		tcpIR.TCPKeepalive = keepalive
 		tcpIR.Connection = connection
 		tcpIR.EnableProxyProtocol = enableProxyProtocol
+		tcpIR.OptionalProxyProtocol = optionalProxyProtocol
 		tcpIR.TLS = tlsConfig
 		tcpIR.Timeout = timeout
 	}
@@ -612,6 +625,16 @@ func buildClientTimeout(clientTimeout *egv1a1.ClientTimeout) (*ir.ClientTimeout,
 				Duration: d,
 			}
 		}
+
+		if clientTimeout.HTTP.StreamIdleTimeout != nil {
+			d, err := time.ParseDuration(string(*clientTimeout.HTTP.StreamIdleTimeout))
+			if err != nil {
+				return nil, fmt.Errorf("invalid HTTP StreamIdleTimeout value %s", *clientTimeout.HTTP.StreamIdleTimeout)
+			}
+			irHTTPTimeout.StreamIdleTimeout = &metav1.Duration{
+				Duration: d,
+			}
+		}
 		irClientTimeout.HTTP = irHTTPTimeout
 	}
 
@@ -753,6 +776,9 @@ func translateHTTP2Settings(http2Settings *egv1a1.HTTP2Settings, httpIR *ir.HTTP
 	}
 
 	http2.MaxConcurrentStreams = http2Settings.MaxConcurrentStreams
+	http2.MaxOutboundFrames = http2Settings.MaxOutboundFrames
+	http2.MaxOutboundControlFrames = http2Settings.MaxOutboundControlFrames
+	http2.MaxConsecutiveInboundFramesWithEmptyPayload = http2Settings.MaxConsecutiveInboundFramesWithEmptyPayload
 
 	httpIR.HTTP2 = http2
 	return errs
@@ -767,6 +793,33 @@ func translateHealthCheckSettings(healthCheckSettings *egv1a1.HealthCheckSetting
 	httpIR.HealthCheck = (*ir.HealthCheckSettings)(healthCheckSettings)
 }
 
+func translateIPTaggingSettings(ipTaggingSettings *egv1a1.IPTaggingSettings, httpIR *ir.HTTPListener) error {
+	// Return early if not set
+	if ipTaggingSettings == nil {
+		return nil
+	}
+
+	ipTagging := &ir.IPTagging{
+		Header: ptr.Deref(ipTaggingSettings.Header, "x-envoy-ip-tags"),
+	}
+	for _, tag := range ipTaggingSettings.Tags {
+		irTag := &ir.IPTag{
+			Name: tag.Name,
+		}
+		for _, cidr := range tag.CIDRs {
+			cidrMatch, err := parseCIDR(string(cidr))
+			if err != nil {
+				return fmt.Errorf("unable to translate tag %s: %w", tag.Name, err)
+			}
+			irTag.CIDRs = append(irTag.CIDRs, cidrMatch)
+		}
+		ipTagging.Tags = append(ipTagging.Tags, irTag)
+	}
+
+	httpIR.IPTagging = ipTagging
+	return nil
+}
+
 func (t *Translator) buildListenerTLSParameters(policy *egv1a1.ClientTrafficPolicy,
 	irTLSConfig *ir.TLSConfig, resources *resource.Resources,
 ) (*ir.TLSConfig, error) {
@@ -870,12 +923,28 @@ func (t *Translator) buildListenerTLSParameters(policy *egv1a1.ClientTrafficPoli
 		if len(irCACert.Certificate) > 0 {
 			irTLSConfig.CACertificate = irCACert
 			irTLSConfig.RequireClientCertificate = !tlsParams.ClientValidation.Optional
+
+			if tlsParams.ClientValidation.Crl != nil {
+				irCrl, err := t.buildClientValidationCrl(policy, tlsParams.ClientValidation.Crl, from, resources)
+				if err != nil {
+					return irTLSConfig, err
+				}
+				irTLSConfig.Crl = irCrl
+			}
 		}
 	}
 
 	if tlsParams.Session != nil && tlsParams.Session.Resumption != nil {
 		if tlsParams.Session.Resumption.Stateless != nil {
 			irTLSConfig.StatelessSessionResumption = true
+
+			if len(tlsParams.Session.Resumption.Stateless.SessionTicketKeysRefs) > 0 {
+				irKeys, err := t.buildSessionTicketKeys(policy, tlsParams.Session.Resumption.Stateless.SessionTicketKeysRefs, resources)
+				if err != nil {
+					return irTLSConfig, err
+				}
+				irTLSConfig.SessionTicketKeys = irKeys
+			}
 		}
 		if tlsParams.Session.Resumption.Stateful != nil {
 			irTLSConfig.StatefulSessionResumption = true
@@ -885,6 +954,100 @@ func (t *Translator) buildListenerTLSParameters(policy *egv1a1.ClientTrafficPoli
 	return irTLSConfig, nil
 }
 
+func (t *Translator) buildClientValidationCrl(
+	policy *egv1a1.ClientTrafficPolicy,
+	crl *egv1a1.CrlContext,
+	from crossNamespaceFrom,
+	resources *resource.Resources,
+) (*ir.TLSCrl, error) {
+	irCrl := &ir.TLSCrl{
+		Name:                      irTLSCrlName(policy.Namespace, policy.Name),
+		OnlyVerifyLeafCertificate: ptr.Deref(crl.OnlyVerifyLeafCertificate, false),
+	}
+
+	for _, crlRef := range crl.Refs {
+		var crlBytes []byte
+		switch {
+		case crlRef.Kind == nil || string(*crlRef.Kind) == resource.KindSecret:
+			secret, err := t.validateSecretRef(false, from, crlRef, resources)
+			if err != nil {
+				return nil, err
+			}
+
+			secretBytes, ok := secret.Data[crlKey]
+			if !ok || len(secretBytes) == 0 {
+				return nil, fmt.Errorf(
+					"crl not found in secret %s", crlRef.Name)
+			}
+			crlBytes = secretBytes
+		case string(*crlRef.Kind) == resource.KindConfigMap:
+			configMap, err := t.validateConfigMapRef(false, from, crlRef, resources)
+			if err != nil {
+				return nil, err
+			}
+
+			configMapData, ok := configMap.Data[crlKey]
+			if !ok || len(configMapData) == 0 {
+				return nil, fmt.Errorf(
+					"crl not found in configmap %s", crlRef.Name)
+			}
+			crlBytes = []byte(configMapData)
+		default:
+			return nil, fmt.Errorf(
+				"unsupported crl ref kind:%s", string(*crlRef.Kind))
+		}
+
+		if err := validateCrl(crlBytes); err != nil {
+			return nil, fmt.Errorf(
+				"invalid crl in %s %s: %w", strings.ToLower(string(ptr.Deref(crlRef.Kind, resource.KindSecret))), crlRef.Name, err)
+		}
+
+		if len(irCrl.Data) > 0 {
+			irCrl.Data = append(irCrl.Data, '\n')
+		}
+		irCrl.Data = append(irCrl.Data, crlBytes...)
+	}
+
+	return irCrl, nil
+}
+
+func (t *Translator) buildSessionTicketKeys(
+	policy *egv1a1.ClientTrafficPolicy,
+	keysRefs []gwapiv1.SecretObjectReference,
+	resources *resource.Resources,
+) (*ir.TLSSessionTicketKeys, error) {
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindClientTrafficPolicy,
+		namespace: policy.Namespace,
+	}
+
+	irKeys := &ir.TLSSessionTicketKeys{
+		Name: irTLSSessionTicketKeysName(policy.Namespace, policy.Name),
+	}
+
+	for _, keyRef := range keysRefs {
+		secret, err := t.validateSecretRef(false, from, keyRef, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := secret.Data[sessionTicketKey]
+		if !ok {
+			return nil, fmt.Errorf(
+				"session ticket key not found in secret %s", keyRef.Name)
+		}
+		if len(key) != sessionTicketKeyLength {
+			return nil, fmt.Errorf(
+				"session ticket key in secret %s must be %d bytes long", keyRef.Name, sessionTicketKeyLength)
+		}
+
+		irKeys.Keys = append(irKeys.Keys, key)
+	}
+
+	return irKeys, nil
+}
+
 func buildConnection(connection *egv1a1.ClientConnection) (*ir.ClientConnection, error) {
 	if connection == nil {
 		return nil, nil
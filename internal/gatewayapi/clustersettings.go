@@ -113,6 +113,7 @@ func buildClusterSettingsTimeout(policy egv1a1.ClusterSettings) (*ir.Timeout, er
 		var cit *metav1.Duration
 		var mcd *metav1.Duration
 		var rt *metav1.Duration
+		var sit *metav1.Duration
 
 		if pto.HTTP.ConnectionIdleTimeout != nil {
 			d, err := time.ParseDuration(string(*pto.HTTP.ConnectionIdleTimeout))
@@ -141,10 +142,20 @@ func buildClusterSettingsTimeout(policy egv1a1.ClusterSettings) (*ir.Timeout, er
 			}
 		}
 
+		if pto.HTTP.StreamIdleTimeout != nil {
+			d, err := time.ParseDuration(string(*pto.HTTP.StreamIdleTimeout))
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("invalid StreamIdleTimeout value %s", *pto.HTTP.StreamIdleTimeout))
+			} else {
+				sit = ptr.To(metav1.Duration{Duration: d})
+			}
+		}
+
 		to.HTTP = &ir.HTTPTimeout{
 			ConnectionIdleTimeout: cit,
 			MaxConnectionDuration: mcd,
 			RequestTimeout:        rt,
+			StreamIdleTimeout:     sit,
 		}
 	}
 	return to, errs
@@ -283,9 +294,13 @@ func buildLoadBalancer(policy egv1a1.ClusterSettings) (*ir.LoadBalancer, error)
 		lb = &ir.LoadBalancer{
 			LeastRequest: &ir.LeastRequest{},
 		}
+		if policy.LoadBalancer.LeastRequest != nil {
+			lb.LeastRequest.ChoiceCount = policy.LoadBalancer.LeastRequest.ChoiceCount
+		}
 		if policy.LoadBalancer.SlowStart != nil && policy.LoadBalancer.SlowStart.Window != nil {
 			lb.LeastRequest.SlowStart = &ir.SlowStart{
-				Window: policy.LoadBalancer.SlowStart.Window,
+				Window:     policy.LoadBalancer.SlowStart.Window,
+				Aggression: policy.LoadBalancer.SlowStart.Aggression,
 			}
 		}
 	case egv1a1.RandomLoadBalancerType:
@@ -298,7 +313,8 @@ func buildLoadBalancer(policy egv1a1.ClusterSettings) (*ir.LoadBalancer, error)
 		}
 		if policy.LoadBalancer.SlowStart != nil && policy.LoadBalancer.SlowStart.Window != nil {
 			lb.RoundRobin.SlowStart = &ir.SlowStart{
-				Window: policy.LoadBalancer.SlowStart.Window,
+				Window:     policy.LoadBalancer.SlowStart.Window,
+				Aggression: policy.LoadBalancer.SlowStart.Aggression,
 			}
 		}
 	}
@@ -319,6 +335,11 @@ func buildConsistentHashLoadBalancer(policy egv1a1.LoadBalancer) (*ir.Consistent
 		consistentHash.TableSize = tableSize
 	}
 
+	if policy.ConsistentHash.Algorithm != nil &&
+		*policy.ConsistentHash.Algorithm == egv1a1.RingHashConsistentHashAlgorithm {
+		consistentHash.RingHash = true
+	}
+
 	switch policy.ConsistentHash.Type {
 	case egv1a1.SourceIPConsistentHashType:
 		consistentHash.SourceIP = ptr.To(true)
@@ -328,17 +349,25 @@ func buildConsistentHashLoadBalancer(policy egv1a1.LoadBalancer) (*ir.Consistent
 		}
 	case egv1a1.CookieConsistentHashType:
 		consistentHash.Cookie = policy.ConsistentHash.Cookie
+	case egv1a1.QueryParameterConsistentHashType:
+		consistentHash.QueryParameter = &ir.QueryParameter{
+			Name: policy.ConsistentHash.QueryParameter.Name,
+		}
 	}
 
 	return consistentHash, nil
 }
 
 func buildProxyProtocol(policy egv1a1.ClusterSettings) *ir.ProxyProtocol {
-	if policy.ProxyProtocol == nil {
+	return translateProxyProtocol(policy.ProxyProtocol)
+}
+
+func translateProxyProtocol(proxyProtocol *egv1a1.ProxyProtocol) *ir.ProxyProtocol {
+	if proxyProtocol == nil {
 		return nil
 	}
 	var pp *ir.ProxyProtocol
-	switch policy.ProxyProtocol.Version {
+	switch proxyProtocol.Version {
 	case egv1a1.ProxyProtocolVersionV1:
 		pp = &ir.ProxyProtocol{
 			Version: ir.ProxyProtocolVersionV1,
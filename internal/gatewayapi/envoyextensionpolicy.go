@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sort"
@@ -14,6 +15,10 @@ import (
 	"time"
 
 	perr "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -32,8 +37,12 @@ import (
 	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
-// oci URL prefix
-const ociURLPrefix = "oci://"
+const (
+	// oci URL prefix
+	ociURLPrefix = "oci://"
+	// the key of the public key used to verify the signature of a Wasm OCI image, as generated by cosign
+	cosignPublicKeyKey = "cosign.pub"
+)
 
 func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy,
 	gateways []*GatewayContext,
@@ -294,9 +303,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 	resources *resource.Resources,
 ) error {
 	var (
-		wasms     []ir.Wasm
-		luas      []ir.Lua
-		err, errs error
+		wasms              []ir.Wasm
+		luas               []ir.Lua
+		grpcJSONTranscoder *ir.GRPCJSONTranscoder
+		err, errs          error
 	)
 
 	if wasms, err = t.buildWasms(policy, resources); err != nil {
@@ -309,6 +319,11 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 		errs = errors.Join(errs, err)
 	}
 
+	if grpcJSONTranscoder, err = buildGRPCJSONTranscoder(policy, resources); err != nil {
+		err = perr.WithMessage(err, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, err)
+	}
+
 	// Apply IR to all relevant routes
 	prefix := irRoutePrefix(route)
 	parentRefs := GetParentReferences(route)
@@ -338,9 +353,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 							continue
 						}
 						r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
-							ExtProcs: extProcs,
-							Wasms:    wasms,
-							Luas:     luas,
+							ExtProcs:           extProcs,
+							Wasms:              wasms,
+							Luas:               luas,
+							GRPCJSONTranscoder: grpcJSONTranscoder,
 						}
 					}
 				}
@@ -359,10 +375,11 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 	resources *resource.Resources,
 ) error {
 	var (
-		extProcs  []ir.ExtProc
-		wasms     []ir.Wasm
-		luas      []ir.Lua
-		err, errs error
+		extProcs           []ir.ExtProc
+		wasms              []ir.Wasm
+		luas               []ir.Lua
+		grpcJSONTranscoder *ir.GRPCJSONTranscoder
+		err, errs          error
 	)
 
 	if extProcs, err = t.buildExtProcs(policy, resources, gateway.envoyProxy); err != nil {
@@ -377,6 +394,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 		err = perr.WithMessage(err, "Lua")
 		errs = errors.Join(errs, err)
 	}
+	if grpcJSONTranscoder, err = buildGRPCJSONTranscoder(policy, resources); err != nil {
+		err = perr.WithMessage(err, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, err)
+	}
 
 	irKey := t.getIRKey(gateway.Gateway)
 	// Should exist since we've validated this
@@ -407,9 +428,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 			}
 
 			r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
-				ExtProcs: extProcs,
-				Wasms:    wasms,
-				Luas:     luas,
+				ExtProcs:           extProcs,
+				Wasms:              wasms,
+				Luas:               luas,
+				GRPCJSONTranscoder: grpcJSONTranscoder,
 			}
 		}
 	}
@@ -483,6 +505,80 @@ func getLuaBodyFromLocalObjectReference(valueRef *gwapiv1.LocalObjectReference,
 	}
 }
 
+func buildGRPCJSONTranscoder(policy *egv1a1.EnvoyExtensionPolicy, resources *resource.Resources) (*ir.GRPCJSONTranscoder, error) {
+	if policy == nil || policy.Spec.GRPCJSONTranscoder == nil {
+		return nil, nil
+	}
+
+	transcoder := policy.Spec.GRPCJSONTranscoder
+	var (
+		descriptor []byte
+		err        error
+	)
+	if transcoder.ProtoDescriptor.Type == egv1a1.ProtoDescriptorValueTypeValueRef {
+		descriptor, err = getProtoDescriptorFromLocalObjectReference(transcoder.ProtoDescriptor.ValueRef, resources, policy.Namespace)
+	} else {
+		descriptor, err = base64.StdEncoding.DecodeString(ptr.Deref(transcoder.ProtoDescriptor.Inline, ""))
+		if err != nil {
+			err = fmt.Errorf("invalid base64 encoding of the inline proto descriptor set: %w", err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = validateProtoDescriptor(descriptor, transcoder.Services); err != nil {
+		return nil, err
+	}
+
+	return &ir.GRPCJSONTranscoder{
+		Name:            irConfigName(policy),
+		ProtoDescriptor: descriptor,
+		Services:        transcoder.Services,
+	}, nil
+}
+
+// getProtoDescriptorFromLocalObjectReference assumes the local object reference points to a Kubernetes ConfigMap
+func getProtoDescriptorFromLocalObjectReference(valueRef *gwapiv1.LocalObjectReference, resources *resource.Resources, policyNs string) ([]byte, error) {
+	cm := resources.GetConfigMap(policyNs, string(valueRef.Name))
+	if cm == nil {
+		return nil, fmt.Errorf("can't find the referenced configmap %s in namespace %s", valueRef.Name, policyNs)
+	}
+
+	if b, ok := cm.BinaryData[egv1a1.ProtoDescriptorSetKey]; ok {
+		return b, nil
+	}
+	// Fallback to the first key if descriptor.pb is not found
+	for _, value := range cm.BinaryData {
+		return value, nil
+	}
+	return nil, fmt.Errorf("can't find the key %s in the binaryData of the referenced configmap %s",
+		egv1a1.ProtoDescriptorSetKey, valueRef.Name)
+}
+
+// validateProtoDescriptor checks that the proto descriptor set can be parsed and defines all
+// the services to transcode, since Envoy would reject the gRPC-JSON transcoder filter otherwise.
+func validateProtoDescriptor(descriptor []byte, services []string) error {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptor, fds); err != nil {
+		return fmt.Errorf("invalid proto descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return fmt.Errorf("invalid proto descriptor set: %w", err)
+	}
+
+	for _, service := range services {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(service))
+		if err != nil {
+			return fmt.Errorf("service %s is not defined in the proto descriptor set", service)
+		}
+		if _, ok := desc.(protoreflect.ServiceDescriptor); !ok {
+			return fmt.Errorf("%s is not a service in the proto descriptor set", service)
+		}
+	}
+	return nil
+}
+
 func (t *Translator) buildExtProcs(policy *egv1a1.EnvoyExtensionPolicy, resources *resource.Resources, envoyProxy *egv1a1.EnvoyProxy) ([]ir.ExtProc, error) {
 	var extProcIRList []ir.ExtProc
 
@@ -552,6 +648,14 @@ func (t *Translator) buildExtProc(
 		extProcIR.MessageTimeout = ptr.To(metav1.Duration{Duration: d})
 	}
 
+	if extProc.MaxMessageTimeout != nil {
+		d, err := time.ParseDuration(string(*extProc.MaxMessageTimeout))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ExtProc MaxMessageTimeout value %v", extProc.MaxMessageTimeout)
+		}
+		extProcIR.MaxMessageTimeout = ptr.To(metav1.Duration{Duration: d})
+	}
+
 	if extProc.FailOpen != nil {
 		extProcIR.FailOpen = extProc.FailOpen
 	}
@@ -703,6 +807,7 @@ func (t *Translator) buildWasm(
 			image      = config.Code.Image
 			secret     *corev1.Secret
 			pullSecret []byte
+			publicKey  []byte
 			// the checksum of the wasm module extracted from the OCI image
 			// it's different from the checksum for the OCI image
 			checksum string
@@ -732,6 +837,25 @@ func (t *Translator) buildWasm(
 			}
 		}
 
+		if image.SignatureVerification != nil {
+			from := crossNamespaceFrom{
+				group:     egv1a1.GroupName,
+				kind:      resource.KindEnvoyExtensionPolicy,
+				namespace: policy.Namespace,
+			}
+
+			if secret, err = t.validateSecretRef(
+				false, from, image.SignatureVerification.PublicKeyRef, resources); err != nil {
+				return nil, err
+			}
+
+			if data, ok := secret.Data[cosignPublicKeyKey]; ok {
+				publicKey = data
+			} else {
+				return nil, fmt.Errorf("missing %s key in secret %s/%s", cosignPublicKeyKey, secret.Namespace, secret.Name)
+			}
+		}
+
 		// Wasm Cache requires the URL to be in the format "scheme://<URL>"
 		imageURL := image.URL
 		if !strings.HasPrefix(image.URL, ociURLPrefix) {
@@ -754,6 +878,7 @@ func (t *Translator) buildWasm(
 		if servingURL, checksum, err = t.WasmCache.Get(imageURL, wasm.GetOptions{
 			Checksum:        originalChecksum,
 			PullSecret:      pullSecret,
+			PublicKey:       publicKey,
 			PullPolicy:      pullPolicy,
 			ResourceName:    irConfigNameForWasm(policy, idx),
 			ResourceVersion: policy.ResourceVersion,
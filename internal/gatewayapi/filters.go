@@ -6,10 +6,12 @@
 package gatewayapi
 
 import (
+	"encoding/base64"
 	"fmt"
 	"regexp"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -51,6 +53,10 @@ type HTTPFilterIR struct {
 
 	URLRewrite *ir.URLRewrite
 
+	BodyMatch *ir.StringMatch
+
+	CredentialInjection *ir.CredentialInjection
+
 	AddRequestHeaders    []ir.AddHeader
 	RemoveRequestHeaders []string
 
@@ -478,6 +484,18 @@ func (t *Translator) processRequestHeaderModifierFilter(
 				)
 				continue
 			}
+			if err := validateHeaderValue(addHeader.Value); err != nil {
+				routeStatus := GetRouteStatus(filterContext.Route)
+				status.SetRouteStatusCondition(routeStatus,
+					filterContext.ParentRef.routeParentStatusIdx,
+					filterContext.Route.GetGeneration(),
+					gwapiv1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					gwapiv1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("RequestHeaderModifier Filter cannot add a header with an invalid value. Header: %q: %v", string(addHeader.Name), err),
+				)
+				continue
+			}
 			// Check if the header is a duplicate
 			headerKey := string(addHeader.Name)
 			canAddHeader := true
@@ -534,6 +552,18 @@ func (t *Translator) processRequestHeaderModifierFilter(
 				)
 				continue
 			}
+			if err := validateHeaderValue(setHeader.Value); err != nil {
+				routeStatus := GetRouteStatus(filterContext.Route)
+				status.SetRouteStatusCondition(routeStatus,
+					filterContext.ParentRef.routeParentStatusIdx,
+					filterContext.Route.GetGeneration(),
+					gwapiv1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					gwapiv1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("RequestHeaderModifier Filter cannot set a header with an invalid value. Header: %q: %v", string(setHeader.Name), err),
+				)
+				continue
+			}
 
 			// Check if the header to be set has already been configured
 			headerKey := string(setHeader.Name)
@@ -650,6 +680,18 @@ func (t *Translator) processResponseHeaderModifierFilter(
 				)
 				continue
 			}
+			if err := validateHeaderValue(addHeader.Value); err != nil {
+				routeStatus := GetRouteStatus(filterContext.Route)
+				status.SetRouteStatusCondition(routeStatus,
+					filterContext.ParentRef.routeParentStatusIdx,
+					filterContext.Route.GetGeneration(),
+					gwapiv1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					gwapiv1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("ResponseHeaderModifier Filter cannot add a header with an invalid value. Header: %q: %v", string(addHeader.Name), err),
+				)
+				continue
+			}
 			// Check if the header is a duplicate
 			headerKey := string(addHeader.Name)
 			canAddHeader := true
@@ -706,6 +748,18 @@ func (t *Translator) processResponseHeaderModifierFilter(
 				)
 				continue
 			}
+			if err := validateHeaderValue(setHeader.Value); err != nil {
+				routeStatus := GetRouteStatus(filterContext.Route)
+				status.SetRouteStatusCondition(routeStatus,
+					filterContext.ParentRef.routeParentStatusIdx,
+					filterContext.Route.GetGeneration(),
+					gwapiv1.RouteConditionAccepted,
+					metav1.ConditionFalse,
+					gwapiv1.RouteReasonUnsupportedValue,
+					fmt.Sprintf("ResponseHeaderModifier Filter cannot set a header with an invalid value. Header: %q: %v", string(setHeader.Name), err),
+				)
+				continue
+			}
 
 			// Check if the header to be set has already been configured
 			headerKey := string(setHeader.Name)
@@ -901,6 +955,48 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *gwapiv1.LocalObjec
 
 				}
 
+				if hrf.Spec.BodyMatch != nil {
+					if filterContext.BodyMatch != nil {
+						routeStatus := GetRouteStatus(filterContext.Route)
+						status.SetRouteStatusCondition(routeStatus,
+							filterContext.ParentRef.routeParentStatusIdx,
+							filterContext.Route.GetGeneration(),
+							gwapiv1.RouteConditionAccepted,
+							metav1.ConditionFalse,
+							gwapiv1.RouteReasonUnsupportedValue,
+							"Cannot configure multiple bodyMatch filters for a single HTTPRouteRule",
+						)
+						return
+					}
+					bodyMatch, err := buildBodyMatch(hrf.Spec.BodyMatch)
+					if err != nil {
+						t.processInvalidHTTPFilter(string(extFilter.Kind), filterContext, err)
+						return
+					}
+					filterContext.HTTPFilterIR.BodyMatch = bodyMatch
+				}
+
+				if hrf.Spec.CredentialInjection != nil {
+					if filterContext.HTTPFilterIR.CredentialInjection != nil {
+						routeStatus := GetRouteStatus(filterContext.Route)
+						status.SetRouteStatusCondition(routeStatus,
+							filterContext.ParentRef.routeParentStatusIdx,
+							filterContext.Route.GetGeneration(),
+							gwapiv1.RouteConditionAccepted,
+							metav1.ConditionFalse,
+							gwapiv1.RouteReasonUnsupportedValue,
+							"Cannot configure multiple credentialInjection filters for a single HTTPRouteRule",
+						)
+						return
+					}
+					credentialInjection, err := t.buildCredentialInjection(hrf, resources)
+					if err != nil {
+						t.processInvalidHTTPFilter(string(extFilter.Kind), filterContext, err)
+						return
+					}
+					filterContext.HTTPFilterIR.CredentialInjection = credentialInjection
+				}
+
 				if hrf.Spec.DirectResponse != nil {
 					dr := &ir.CustomResponse{}
 					if hrf.Spec.DirectResponse.Body != nil {
@@ -1066,10 +1162,124 @@ func (t *Translator) processInvalidHTTPFilter(filterType string, filterContext *
 		filterContext.Route.GetGeneration(),
 		gwapiv1.RouteConditionAccepted,
 		metav1.ConditionFalse,
-		gwapiv1.RouteReasonUnsupportedValue,
+		status.RouteReasonInvalidFilter,
 		errMsg,
 	)
 	filterContext.DirectResponse = &ir.CustomResponse{
 		StatusCode: ptr.To(uint32(500)),
 	}
 }
+
+// commandOperatorRegex matches an Envoy command operator at the start of a string,
+// e.g. %DOWNSTREAM_REMOTE_ADDRESS%, %REQ(X-REQUEST-ID)%, %REQ(:PATH):10% or %START_TIME(%s)%.
+var commandOperatorRegex = regexp.MustCompile(`^%[A-Z][A-Z0-9_]*(\([^)]*\))?(:[0-9]+)?%`)
+
+// validateHeaderValue checks that the command operators in a header value are well-formed,
+// as Envoy rejects the whole route configuration otherwise.
+// A literal '%' must be escaped as '%%'.
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#custom-request-response-headers
+func validateHeaderValue(value string) error {
+	for i := 0; i < len(value); {
+		switch {
+		case value[i] != '%':
+			i++
+		case strings.HasPrefix(value[i:], "%%"):
+			i += 2
+		default:
+			operator := commandOperatorRegex.FindString(value[i:])
+			if operator == "" {
+				return fmt.Errorf("invalid command operator at position %d, a literal '%%' must be escaped as '%%%%'", i)
+			}
+			i += len(operator)
+		}
+	}
+	return nil
+}
+
+// buildBodyMatch translates the match on a field of the JSON request body to a StringMatch
+// named after the path of the field.
+func buildBodyMatch(bodyMatch *egv1a1.HTTPBodyMatch) (*ir.StringMatch, error) {
+	match := &ir.StringMatch{
+		Name: bodyMatch.JSONPath,
+	}
+	value := bodyMatch.Value.Value
+	matchType := egv1a1.StringMatchExact
+	if bodyMatch.Value.Type != nil {
+		matchType = *bodyMatch.Value.Type
+	}
+	switch matchType {
+	case egv1a1.StringMatchExact:
+		match.Exact = &value
+	case egv1a1.StringMatchPrefix:
+		match.Prefix = &value
+	case egv1a1.StringMatchSuffix:
+		match.Suffix = &value
+	case egv1a1.StringMatchRegularExpression:
+		if _, err := regexp.Compile(value); err != nil {
+			return nil, fmt.Errorf("bodyMatch value must be a valid RE2 regular expression: %w", err)
+		}
+		match.SafeRegex = &value
+	default:
+		return nil, fmt.Errorf("unsupported bodyMatch value type %s", matchType)
+	}
+	return match, nil
+}
+
+// buildCredentialInjection builds the IR of the credential stored in the Secret
+// referenced by the credentialInjection of the HTTPRouteFilter.
+func (t *Translator) buildCredentialInjection(hrf *egv1a1.HTTPRouteFilter, resources *resource.Resources) (*ir.CredentialInjection, error) {
+	credentialInjection := hrf.Spec.CredentialInjection
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindHTTPRouteFilter,
+		namespace: hrf.Namespace,
+	}
+	secret, err := t.validateSecretRef(true, from, credentialInjection.SecretRef, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		header = "Authorization"
+		value  string
+	)
+	switch credentialInjection.Type {
+	case egv1a1.APIKeyCredentialInjectionType, egv1a1.BearerCredentialInjectionType:
+		// The Secrets created from files often end with a newline, which isn't part of the credential.
+		credential := strings.TrimSpace(string(secret.Data[egv1a1.CredentialInjectionSecretKey]))
+		if len(credential) == 0 {
+			return nil, fmt.Errorf("credential not found in secret %s/%s", secret.Namespace, secret.Name)
+		}
+		// These characters aren't allowed in a header value.
+		if strings.ContainsAny(credential, "\r\n\x00") {
+			return nil, fmt.Errorf("credential in secret %s/%s contains a carriage return, a line feed or a null character",
+				secret.Namespace, secret.Name)
+		}
+		if credentialInjection.Type == egv1a1.APIKeyCredentialInjectionType {
+			header = "X-API-Key"
+			value = credential
+		} else {
+			value = "Bearer " + credential
+		}
+	case egv1a1.BasicCredentialInjectionType:
+		username := strings.TrimSpace(string(secret.Data[corev1.BasicAuthUsernameKey]))
+		if len(username) == 0 {
+			return nil, fmt.Errorf("username not found in secret %s/%s", secret.Namespace, secret.Name)
+		}
+		password := strings.TrimSpace(string(secret.Data[corev1.BasicAuthPasswordKey]))
+		value = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	default:
+		return nil, fmt.Errorf("unsupported credentialInjection type %s", credentialInjection.Type)
+	}
+
+	if credentialInjection.Header != nil {
+		header = *credentialInjection.Header
+	}
+
+	return &ir.CredentialInjection{
+		Name:       fmt.Sprintf("%s/%s/%s", strings.ToLower(resource.KindHTTPRouteFilter), hrf.Namespace, hrf.Name),
+		Header:     header,
+		Credential: []byte(value),
+	}, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/status"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/utils"
 )
@@ -37,6 +38,11 @@ const (
 	L7Protocol = "L7"
 
 	caCertKey = "ca.crt"
+	crlKey    = "ca.crl"
+
+	sessionTicketKey = "ticket.key"
+	// sessionTicketKeyLength is the length of the session ticket keys expected by Envoy.
+	sessionTicketKeyLength = 80
 )
 
 type protocolPort struct {
@@ -286,13 +292,13 @@ func computeHosts(routeHostnames []string, listenerContext *ListenerContext) []s
 
 		// Listener has a wildcard hostname: check if the route hostname matches.
 		case strings.HasPrefix(listenerHostnameVal, "*"):
-			if hostnameMatchesWildcardHostname(routeHostname, listenerHostnameVal) {
+			if HostnameMatchesWildcardHostname(routeHostname, listenerHostnameVal) {
 				hostnamesSet.Insert(routeHostname)
 			}
 
 		// Route has a wildcard hostname: check if the listener hostname matches.
 		case strings.HasPrefix(routeHostname, "*"):
-			if hostnameMatchesWildcardHostname(listenerHostnameVal, routeHostname) {
+			if HostnameMatchesWildcardHostname(listenerHostnameVal, routeHostname) {
 				hostnamesSet.Insert(listenerHostnameVal)
 			}
 
@@ -321,10 +327,10 @@ func computeHosts(routeHostnames []string, listenerContext *ListenerContext) []s
 	return hostnamesSet.List()
 }
 
-// hostnameMatchesWildcardHostname returns true if hostname has the non-wildcard
+// HostnameMatchesWildcardHostname returns true if hostname has the non-wildcard
 // portion of wildcardHostname as a suffix, plus at least one DNS label matching the
 // wildcard.
-func hostnameMatchesWildcardHostname(hostname, wildcardHostname string) bool {
+func HostnameMatchesWildcardHostname(hostname, wildcardHostname string) bool {
 	if !strings.HasSuffix(hostname, strings.TrimPrefix(wildcardHostname, "*")) {
 		return false
 	}
@@ -445,6 +451,14 @@ func irTLSCACertName(namespace, name string) string {
 	return fmt.Sprintf("%s/%s/%s", namespace, name, caCertKey)
 }
 
+func irTLSCrlName(namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, name, crlKey)
+}
+
+func irTLSSessionTicketKeysName(namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, name, sessionTicketKey)
+}
+
 func IsMergeGatewaysEnabled(resources *resource.Resources) bool {
 	return resources.EnvoyProxyForGatewayClass != nil && resources.EnvoyProxyForGatewayClass.Spec.MergeGateways != nil && *resources.EnvoyProxyForGatewayClass.Spec.MergeGateways
 }
@@ -477,6 +491,75 @@ type policyTargetRouteKey struct {
 type policyRouteTargetContext struct {
 	RouteContext
 	attached bool
+	// attachedToRouteRules is the set of the route rules targeted by a policy
+	// with a sectionName.
+	attachedToRouteRules sets.Set[string]
+}
+
+// resolveRouteRuleTargetRef checks that the route has a rule named sectionName,
+// and that no other policy of the same kind has already attached to this rule.
+func resolveRouteRuleTargetRef(policyKind string, route *policyRouteTargetContext, sectionName gwapiv1.SectionName) *status.PolicyResolveError {
+	if !routeRuleNames(route.RouteContext).Has(string(sectionName)) {
+		message := fmt.Sprintf("No section name %s found for %s %s",
+			sectionName, GetRouteType(route.RouteContext), utils.NamespacedName(route).String())
+
+		return &status.PolicyResolveError{
+			Reason:  gwapiv1a2.PolicyReasonInvalid,
+			Message: message,
+		}
+	}
+
+	if route.attachedToRouteRules.Has(string(sectionName)) {
+		message := fmt.Sprintf("Unable to target section %s of %s %s, another %s has already attached to it",
+			sectionName, GetRouteType(route.RouteContext), route.GetName(), policyKind)
+
+		return &status.PolicyResolveError{
+			Reason:  gwapiv1a2.PolicyReasonConflicted,
+			Message: message,
+		}
+	}
+
+	if route.attachedToRouteRules == nil {
+		route.attachedToRouteRules = make(sets.Set[string])
+	}
+	route.attachedToRouteRules.Insert(string(sectionName))
+
+	return nil
+}
+
+// routeRuleNames returns the names of the rules of an HTTPRoute or a GRPCRoute.
+func routeRuleNames(route RouteContext) sets.Set[string] {
+	names := make(sets.Set[string])
+	switch r := route.(type) {
+	case *HTTPRouteContext:
+		for _, rule := range r.Spec.Rules {
+			if rule.Name != nil {
+				names.Insert(string(*rule.Name))
+			}
+		}
+	case *GRPCRouteContext:
+		for _, rule := range r.Spec.Rules {
+			if rule.Name != nil {
+				names.Insert(string(*rule.Name))
+			}
+		}
+	}
+	return names
+}
+
+// irListenerBelongsToGateway returns true if gateway is not set, or if the IR
+// listener has been built from a listener of this gateway.
+func irListenerBelongsToGateway(listenerName string, gateway *types.NamespacedName) bool {
+	return gateway == nil || strings.HasPrefix(listenerName, gateway.String()+"/")
+}
+
+// irRouteMatchesRouteRule returns true if sectionName is not set, or if the IR
+// route has been built from the route rule named sectionName.
+func irRouteMatchesRouteRule(r *ir.HTTPRoute, sectionName *gwapiv1.SectionName) bool {
+	if sectionName == nil {
+		return true
+	}
+	return r.Metadata != nil && r.Metadata.SectionName == string(*sectionName)
 }
 
 type policyGatewayTargetContext struct {
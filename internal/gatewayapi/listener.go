@@ -487,6 +487,9 @@ func (t *Translator) processTracing(gw *gwapiv1.Gateway, envoyproxy *egv1a1.Envo
 	if mergeGateways {
 		serviceName = string(gw.Spec.GatewayClassName)
 	}
+	if tracing.Provider.ServiceName != nil {
+		serviceName = *tracing.Provider.ServiceName
+	}
 
 	return &ir.Tracing{
 		Authority:    authority,
@@ -530,11 +533,17 @@ func (t *Translator) processMetrics(envoyproxy *egv1a1.EnvoyProxy, resources *re
 	}
 
 	for _, sink := range envoyproxy.Spec.Telemetry.Metrics.Sinks {
-		if sink.OpenTelemetry == nil {
+		var backendCluster egv1a1.BackendCluster
+		switch {
+		case sink.OpenTelemetry != nil:
+			backendCluster = sink.OpenTelemetry.BackendCluster
+		case sink.StatsD != nil:
+			backendCluster = sink.StatsD.BackendCluster
+		default:
 			continue
 		}
 
-		_, _, err := t.processBackendRefs(sink.OpenTelemetry.BackendCluster, envoyproxy.Namespace, resources, envoyproxy)
+		_, _, err := t.processBackendRefs(backendCluster, envoyproxy.Namespace, resources, envoyproxy)
 		if err != nil {
 			return nil, err
 		}
@@ -542,6 +551,7 @@ func (t *Translator) processMetrics(envoyproxy *egv1a1.EnvoyProxy, resources *re
 
 	return &ir.Metrics{
 		EnableVirtualHostStats:          ptr.Deref(envoyproxy.Spec.Telemetry.Metrics.EnableVirtualHostStats, false),
+		EnableRouteStats:                ptr.Deref(envoyproxy.Spec.Telemetry.Metrics.EnableRouteStats, false),
 		EnablePerEndpointStats:          ptr.Deref(envoyproxy.Spec.Telemetry.Metrics.EnablePerEndpointStats, false),
 		EnableRequestResponseSizesStats: ptr.Deref(envoyproxy.Spec.Telemetry.Metrics.EnableRequestResponseSizesStats, false),
 	}, nil
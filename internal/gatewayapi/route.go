@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"sort"
@@ -51,6 +52,12 @@ type RoutesTranslator interface {
 	ProcessUDPRoutes(udpRoutes []*gwapiv1a2.UDPRoute, gateways []*GatewayContext, resources *resource.Resources, xdsIR resource.XdsIRMap) []*UDPRouteContext
 }
 
+// ProcessHTTPRoutes translates the HTTPRoutes attached to the Gateways into the xds IR.
+// The routes are translated serially, in the order of their creation timestamps.
+// They can't be translated concurrently, because translating a route updates state
+// shared with the other routes: the attached route counts of the listeners, the
+// statuses of the BackendTLSPolicies and the routes of the IR listeners, whose
+// order is part of the translation output.
 func (t *Translator) ProcessHTTPRoutes(httpRoutes []*gwapiv1.HTTPRoute, gateways []*GatewayContext, resources *resource.Resources, xdsIR resource.XdsIRMap) []*HTTPRouteContext {
 	var relevantHTTPRoutes []*HTTPRouteContext
 
@@ -118,7 +125,7 @@ func (t *Translator) processHTTPRouteParentRefs(httpRoute *HTTPRouteContext, res
 		// Need to compute Route rules within the parentRef loop because
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
-		routeRoutes, err := t.processHTTPRouteRules(httpRoute, parentRef, resources)
+		routeRoutes, droppedRuleErrs, err := t.processHTTPRouteRules(httpRoute, parentRef, resources)
 		if err != nil {
 			routeStatus := GetRouteStatus(httpRoute)
 			status.SetRouteStatusCondition(routeStatus,
@@ -126,7 +133,7 @@ func (t *Translator) processHTTPRouteParentRefs(httpRoute *HTTPRouteContext, res
 				httpRoute.GetGeneration(),
 				gwapiv1.RouteConditionAccepted,
 				metav1.ConditionFalse,
-				gwapiv1.RouteReasonUnsupportedValue, // TODO: better reason
+				status.RouteRuleErrorReason(err),
 				status.Error2ConditionMsg(err),
 			)
 			continue
@@ -177,11 +184,15 @@ func (t *Translator) processHTTPRouteParentRefs(httpRoute *HTTPRouteContext, res
 			)
 		}
 
+		if len(droppedRuleErrs) > 0 && parentRef.HasCondition(httpRoute, gwapiv1.RouteConditionAccepted, metav1.ConditionTrue) {
+			setRoutePartiallyInvalid(httpRoute, parentRef, droppedRuleErrs)
+		}
 	}
 }
 
-func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRef *RouteParentContext, resources *resource.Resources) ([]*ir.HTTPRoute, error) {
+func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRef *RouteParentContext, resources *resource.Resources) ([]*ir.HTTPRoute, []error, error) {
 	var routeRoutes []*ir.HTTPRoute
+	var ruleErrs []error
 	var envoyProxy *egv1a1.EnvoyProxy
 
 	gatewayCtx := httpRoute.ParentRefs[*parentRef.ParentReference].GetGateway()
@@ -193,14 +204,16 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 	for ruleIdx, rule := range httpRoute.Spec.Rules {
 		httpFiltersContext, err := t.ProcessHTTPFilters(parentRef, httpRoute, rule.Filters, ruleIdx, resources)
 		if err != nil {
-			return nil, err
+			ruleErrs = append(ruleErrs, newDroppedRuleError(ruleIdx, status.NewRouteRuleError(status.RouteReasonInvalidFilter, err)))
+			continue
 		}
 		// A rule is matched if any one of its matches
 		// is satisfied (i.e. a logical "OR"), so generate
 		// a unique Xds IR HTTPRoute per match.
 		ruleRoutes, err := t.processHTTPRouteRule(httpRoute, ruleIdx, httpFiltersContext, rule)
 		if err != nil {
-			return nil, err
+			ruleErrs = append(ruleErrs, newDroppedRuleError(ruleIdx, err))
+			continue
 		}
 
 		dstAddrTypeMap := make(map[ir.DestinationAddressType]int)
@@ -268,7 +281,51 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 		routeRoutes = append(routeRoutes, ruleRoutes...)
 	}
 
-	return routeRoutes, nil
+	// The route is only rejected if all its rules are invalid, otherwise the invalid rules are dropped.
+	if len(ruleErrs) > 0 && len(routeRoutes) == 0 {
+		return nil, nil, errors.Unwrap(ruleErrs[0])
+	}
+
+	return routeRoutes, ruleErrs, nil
+}
+
+// droppedRuleError is the error of a route rule that is dropped from the translation of the route.
+type droppedRuleError struct {
+	ruleIdx int
+	err     error
+}
+
+func newDroppedRuleError(ruleIdx int, err error) *droppedRuleError {
+	return &droppedRuleError{
+		ruleIdx: ruleIdx,
+		err:     err,
+	}
+}
+
+func (e *droppedRuleError) Error() string {
+	return fmt.Sprintf("rule %d: %v", e.ruleIdx, e.err)
+}
+
+func (e *droppedRuleError) Unwrap() error {
+	return e.err
+}
+
+// setRoutePartiallyInvalid sets the PartiallyInvalid condition of the route for the parentRef,
+// with the errors of the dropped rules in the message.
+func setRoutePartiallyInvalid(route RouteContext, parentRef *RouteParentContext, ruleErrs []error) {
+	msgs := make([]string, 0, len(ruleErrs))
+	for _, err := range ruleErrs {
+		msgs = append(msgs, err.Error())
+	}
+	routeStatus := GetRouteStatus(route)
+	status.SetRouteStatusCondition(routeStatus,
+		parentRef.routeParentStatusIdx,
+		route.GetGeneration(),
+		gwapiv1.RouteConditionPartiallyInvalid,
+		metav1.ConditionTrue,
+		status.RouteRuleErrorReason(ruleErrs[0]),
+		fmt.Sprintf("Dropped Rule(s): %s.", strings.Join(msgs, "; ")),
+	)
 }
 
 func processRouteTrafficFeatures(irRoute *ir.HTTPRoute, rule gwapiv1.HTTPRouteRule) {
@@ -286,9 +343,10 @@ func processRouteTimeout(irRoute *ir.HTTPRoute, rule gwapiv1.HTTPRouteRule) {
 			irRoute.Timeout = ptr.To(metav1.Duration{Duration: d})
 		}
 
-		// Also set the IR Route Timeout to the backend request timeout
-		// until we introduce retries, then set it to per try timeout
-		if rule.Timeouts.BackendRequest != nil {
+		// When the rule has no retry, the backend request timeout bounds the only request
+		// sent to the backend, so it's also used as the IR Route Timeout.
+		// Otherwise, it's the per try timeout of the retry policy, see processRouteRetry.
+		if rule.Timeouts.BackendRequest != nil && rule.Retry == nil {
 			d, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
 			if err != nil {
 				d, _ = time.ParseDuration(HTTPRequestTimeout)
@@ -316,13 +374,16 @@ func processRouteRetry(irRoute *ir.HTTPRoute, rule gwapiv1.HTTPRouteRule) {
 					BaseInterval: ptr.To(metav1.Duration{Duration: backoff}),
 				},
 			}
-			// xref: https://gateway-api.sigs.k8s.io/geps/gep-1742/#timeout-values
-			if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
-				backendRequestTimeout, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
-				if err == nil {
-					res.PerRetry.Timeout = &metav1.Duration{Duration: backendRequestTimeout}
-				}
+		}
+	}
+	// xref: https://gateway-api.sigs.k8s.io/geps/gep-1742/#timeout-values
+	if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
+		backendRequestTimeout, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
+		if err == nil {
+			if res.PerRetry == nil {
+				res.PerRetry = &ir.PerRetryPolicy{}
 			}
+			res.PerRetry.Timeout = &metav1.Duration{Duration: backendRequestTimeout}
 		}
 	}
 	if len(retry.Codes) > 0 {
@@ -337,6 +398,15 @@ func processRouteRetry(irRoute *ir.HTTPRoute, rule gwapiv1.HTTPRouteRule) {
 	irRoute.Retry = res
 }
 
+// isDefaultPathMatch returns true if the path match is unset or the default prefix match of "/".
+func isDefaultPathMatch(pathMatch *gwapiv1.HTTPPathMatch) bool {
+	if pathMatch == nil {
+		return true
+	}
+	return PathMatchTypeDerefOr(pathMatch.Type, gwapiv1.PathMatchPathPrefix) == gwapiv1.PathMatchPathPrefix &&
+		ptr.Deref(pathMatch.Value, "/") == "/"
+}
+
 func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx int, httpFiltersContext *HTTPFiltersContext, rule gwapiv1.HTTPRouteRule) ([]*ir.HTTPRoute, error) {
 	var ruleRoutes []*ir.HTTPRoute
 
@@ -353,8 +423,10 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 
 	var sessionPersistence *ir.SessionPersistence
 	if rule.SessionPersistence != nil {
+		// Envoy doesn't refresh a session on each request, so an idle timeout can't be enforced.
 		if rule.SessionPersistence.IdleTimeout != nil {
-			return nil, fmt.Errorf("idle timeout is not supported in envoy gateway")
+			return nil, status.NewRouteRuleError(status.RouteReasonUnsupportedSessionPersistence,
+				errors.New("idle timeout is not supported for session persistence, sessions are not refreshed on each request"))
 		}
 
 		var sessionName string
@@ -382,7 +454,7 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 				*rule.SessionPersistence.CookieConfig.LifetimeType == gwapiv1.PermanentCookieLifetimeType {
 				ttl, err := time.ParseDuration(string(*rule.SessionPersistence.AbsoluteTimeout))
 				if err != nil {
-					return nil, err
+					return nil, status.NewRouteRuleError(status.RouteReasonInvalidTimeout, err)
 				}
 				sessionPersistence.Cookie.TTL = &metav1.Duration{Duration: ttl}
 			}
@@ -394,7 +466,8 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 			}
 		default:
 			// Unknown session persistence type is specified.
-			return nil, fmt.Errorf("unknown session persistence type %s", *rule.SessionPersistence.Type)
+			return nil, status.NewRouteRuleError(status.RouteReasonUnsupportedSessionPersistence,
+				fmt.Errorf("unknown session persistence type %s", *rule.SessionPersistence.Type))
 		}
 	}
 
@@ -409,6 +482,12 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 		irRoute.Metadata = buildRouteMetadata(httpRoute, rule.Name)
 		processRouteTrafficFeatures(irRoute, rule)
 
+		// The CONNECT requests have no path, so only the default path match is allowed.
+		if match.Method != nil && *match.Method == gwapiv1.HTTPMethodConnect && !isDefaultPathMatch(match.Path) {
+			return nil, status.NewRouteRuleError(gwapiv1.RouteReasonUnsupportedValue,
+				errors.New("path matches are not supported with the CONNECT method"))
+		}
+
 		if match.Path != nil {
 			switch PathMatchTypeDerefOr(match.Path.Type, gwapiv1.PathMatchPathPrefix) {
 			case gwapiv1.PathMatchPathPrefix:
@@ -421,7 +500,7 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 				}
 			case gwapiv1.PathMatchRegularExpression:
 				if err := regex.Validate(*match.Path.Value); err != nil {
-					return nil, err
+					return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 				}
 				irRoute.PathMatch = &ir.StringMatch{
 					SafeRegex: match.Path.Value,
@@ -437,7 +516,7 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 				})
 			case gwapiv1.HeaderMatchRegularExpression:
 				if err := regex.Validate(headerMatch.Value); err != nil {
-					return nil, err
+					return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 				}
 				irRoute.HeaderMatches = append(irRoute.HeaderMatches, &ir.StringMatch{
 					Name:      string(headerMatch.Name),
@@ -454,7 +533,7 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 				})
 			case gwapiv1.QueryParamMatchRegularExpression:
 				if err := regex.Validate(queryParamMatch.Value); err != nil {
-					return nil, err
+					return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 				}
 				irRoute.QueryParamMatches = append(irRoute.QueryParamMatches, &ir.StringMatch{
 					Name:      string(queryParamMatch.Name),
@@ -468,6 +547,11 @@ func (t *Translator) processHTTPRouteRule(httpRoute *HTTPRouteContext, ruleIdx i
 				Name:  ":method",
 				Exact: ptr.To(string(*match.Method)),
 			})
+			// The CONNECT requests have no path, they are terminated by Envoy and
+			// their payload is tunneled to the backends over TCP.
+			if *match.Method == gwapiv1.HTTPMethodConnect {
+				irRoute.ConnectTermination = true
+			}
 		}
 		applyHTTPFiltersContextToIRRoute(httpFiltersContext, irRoute)
 		ruleRoutes = append(ruleRoutes, irRoute)
@@ -487,6 +571,12 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if httpFiltersContext.URLRewrite != nil {
 		irRoute.URLRewrite = httpFiltersContext.URLRewrite
 	}
+	if httpFiltersContext.BodyMatch != nil {
+		irRoute.BodyMatch = httpFiltersContext.BodyMatch
+	}
+	if httpFiltersContext.CredentialInjection != nil {
+		irRoute.CredentialInjection = httpFiltersContext.CredentialInjection
+	}
 	if len(httpFiltersContext.AddRequestHeaders) > 0 {
 		irRoute.AddRequestHeaders = httpFiltersContext.AddRequestHeaders
 	}
@@ -514,7 +604,7 @@ func (t *Translator) processGRPCRouteParentRefs(grpcRoute *GRPCRouteContext, res
 		// Need to compute Route rules within the parentRef loop because
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
-		routeRoutes, err := t.processGRPCRouteRules(grpcRoute, parentRef, resources)
+		routeRoutes, droppedRuleErrs, err := t.processGRPCRouteRules(grpcRoute, parentRef, resources)
 		if err != nil {
 			routeStatus := GetRouteStatus(grpcRoute)
 			status.SetRouteStatusCondition(routeStatus,
@@ -522,7 +612,7 @@ func (t *Translator) processGRPCRouteParentRefs(grpcRoute *GRPCRouteContext, res
 				grpcRoute.GetGeneration(),
 				gwapiv1.RouteConditionAccepted,
 				metav1.ConditionFalse,
-				gwapiv1.RouteReasonUnsupportedValue, // TODO: better reason
+				status.RouteRuleErrorReason(err),
 				status.Error2ConditionMsg(err),
 			)
 			continue
@@ -571,24 +661,30 @@ func (t *Translator) processGRPCRouteParentRefs(grpcRoute *GRPCRouteContext, res
 			)
 		}
 
+		if len(droppedRuleErrs) > 0 && parentRef.HasCondition(grpcRoute, gwapiv1.RouteConditionAccepted, metav1.ConditionTrue) {
+			setRoutePartiallyInvalid(grpcRoute, parentRef, droppedRuleErrs)
+		}
 	}
 }
 
-func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRef *RouteParentContext, resources *resource.Resources) ([]*ir.HTTPRoute, error) {
+func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRef *RouteParentContext, resources *resource.Resources) ([]*ir.HTTPRoute, []error, error) {
 	var routeRoutes []*ir.HTTPRoute
+	var ruleErrs []error
 
 	// compute matches, filters, backends
 	for ruleIdx, rule := range grpcRoute.Spec.Rules {
 		httpFiltersContext, err := t.ProcessGRPCFilters(parentRef, grpcRoute, rule.Filters, resources)
 		if err != nil {
-			return nil, err
+			ruleErrs = append(ruleErrs, newDroppedRuleError(ruleIdx, status.NewRouteRuleError(status.RouteReasonInvalidFilter, err)))
+			continue
 		}
 		// A rule is matched if any one of its matches
 		// is satisfied (i.e. a logical "OR"), so generate
 		// a unique Xds IR HTTPRoute per match.
 		ruleRoutes, err := t.processGRPCRouteRule(grpcRoute, ruleIdx, httpFiltersContext, rule)
 		if err != nil {
-			return nil, err
+			ruleErrs = append(ruleErrs, newDroppedRuleError(ruleIdx, err))
+			continue
 		}
 
 		for _, backendRef := range rule.BackendRefs {
@@ -638,7 +734,12 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 		routeRoutes = append(routeRoutes, ruleRoutes...)
 	}
 
-	return routeRoutes, nil
+	// The route is only rejected if all its rules are invalid, otherwise the invalid rules are dropped.
+	if len(ruleErrs) > 0 && len(routeRoutes) == 0 {
+		return nil, nil, errors.Unwrap(ruleErrs[0])
+	}
+
+	return routeRoutes, ruleErrs, nil
 }
 
 func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx int, httpFiltersContext *HTTPFiltersContext, rule gwapiv1.GRPCRouteRule) ([]*ir.HTTPRoute, error) {
@@ -671,7 +772,7 @@ func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx i
 				})
 			case gwapiv1.GRPCHeaderMatchRegularExpression:
 				if err := regex.Validate(headerMatch.Value); err != nil {
-					return nil, err
+					return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 				}
 				irRoute.HeaderMatches = append(irRoute.HeaderMatches, &ir.StringMatch{
 					Name:      string(headerMatch.Name),
@@ -688,12 +789,12 @@ func (t *Translator) processGRPCRouteRule(grpcRoute *GRPCRouteContext, ruleIdx i
 			case gwapiv1.GRPCMethodMatchRegularExpression:
 				if match.Method.Service != nil {
 					if err := regex.Validate(*match.Method.Service); err != nil {
-						return nil, err
+						return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 					}
 				}
 				if match.Method.Method != nil {
 					if err := regex.Validate(*match.Method.Method); err != nil {
-						return nil, err
+						return nil, status.NewRouteRuleError(status.RouteReasonInvalidRegex, err)
 					}
 				}
 				t.processGRPCRouteMethodRegularExpression(match.Method, irRoute)
@@ -782,10 +883,13 @@ func (t *Translator) processHTTPRouteParentRefListener(route RouteContext, route
 					PathMatch:             routeRoute.PathMatch,
 					HeaderMatches:         routeRoute.HeaderMatches,
 					QueryParamMatches:     routeRoute.QueryParamMatches,
+					BodyMatch:             routeRoute.BodyMatch,
+					ConnectTermination:    routeRoute.ConnectTermination,
 					AddRequestHeaders:     routeRoute.AddRequestHeaders,
 					RemoveRequestHeaders:  routeRoute.RemoveRequestHeaders,
 					AddResponseHeaders:    routeRoute.AddResponseHeaders,
 					RemoveResponseHeaders: routeRoute.RemoveResponseHeaders,
+					CredentialInjection:   routeRoute.CredentialInjection,
 					Destination:           routeRoute.Destination,
 					Redirect:              routeRoute.Redirect,
 					DirectResponse:        routeRoute.DirectResponse,
@@ -1789,9 +1893,10 @@ func (t *Translator) processBackendDestinationSetting(backendRef gwapiv1.Backend
 	}
 
 	ds := &ir.DestinationSetting{
-		Protocol:    protocol,
-		Endpoints:   dstEndpoints,
-		AddressType: dstAddrType,
+		Protocol:      protocol,
+		Endpoints:     dstEndpoints,
+		AddressType:   dstAddrType,
+		ProxyProtocol: translateProxyProtocol(backend.Spec.ProxyProtocol),
 	}
 
 	if backend.Spec.Fallback != nil {
@@ -13,8 +13,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -226,3 +230,142 @@ func TestGetIREndpointsFromEndpointSlices(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkTranslateHTTPRoutes(b *testing.B) {
+	for _, numRoutes := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("routes-%d", numRoutes), func(b *testing.B) {
+			resources := newHTTPRoutesBenchmarkResources(numRoutes)
+			translator := &Translator{
+				GatewayControllerName: egv1a1.GatewayControllerName,
+				GatewayClassName:      "envoy-gateway-class",
+				Namespace:             "envoy-gateway-system",
+			}
+
+			// Ensure that all the routes are translated before measuring the translation.
+			result, err := translator.Translate(resources)
+			require.NoError(b, err)
+			require.Len(b, result.XdsIR["default/gateway-1"].HTTP[0].Routes, numRoutes)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := translator.Translate(resources)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// newHTTPRoutesBenchmarkResources returns a Gateway with an HTTP listener, and numRoutes HTTPRoutes
+// attached to it, each with a distinct hostname and a rule forwarding the requests to a Service.
+func newHTTPRoutesBenchmarkResources(numRoutes int) *resource.Resources {
+	resources := resource.NewResources()
+	resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gwapiv1.GroupVersion.String(),
+			Kind:       resource.KindGateway,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gateway-1",
+		},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: "envoy-gateway-class",
+			Listeners: []gwapiv1.Listener{
+				{
+					Name:     "http",
+					Protocol: gwapiv1.HTTPProtocolType,
+					Port:     80,
+					AllowedRoutes: &gwapiv1.AllowedRoutes{
+						Namespaces: &gwapiv1.RouteNamespaces{From: ptr.To(gwapiv1.NamespacesFromAll)},
+					},
+				},
+			},
+		},
+	})
+	resources.Namespaces = append(resources.Namespaces, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	})
+	resources.Services = append(resources.Services, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "service-1",
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "1.1.1.1",
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       8080,
+					TargetPort: intstr.FromInt32(8080),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	})
+	resources.EndpointSlices = append(resources.EndpointSlices, &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "endpointslice-1",
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: "service-1",
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Ports: []discoveryv1.EndpointPort{
+			{
+				Name:     ptr.To("http"),
+				Port:     ptr.To[int32](8080),
+				Protocol: ptr.To(corev1.ProtocolTCP),
+			},
+		},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"7.7.7.7"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+			},
+		},
+	})
+
+	for i := 0; i < numRoutes; i++ {
+		resources.HTTPRoutes = append(resources.HTTPRoutes, &gwapiv1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gwapiv1.GroupVersion.String(),
+				Kind:       resource.KindHTTPRoute,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      fmt.Sprintf("httproute-%d", i),
+			},
+			Spec: gwapiv1.HTTPRouteSpec{
+				CommonRouteSpec: gwapiv1.CommonRouteSpec{
+					ParentRefs: []gwapiv1.ParentReference{{Name: "gateway-1"}},
+				},
+				Hostnames: []gwapiv1.Hostname{gwapiv1.Hostname(fmt.Sprintf("route-%d.example.com", i))},
+				Rules: []gwapiv1.HTTPRouteRule{
+					{
+						Matches: []gwapiv1.HTTPRouteMatch{
+							{
+								Path: &gwapiv1.HTTPPathMatch{
+									Type:  ptr.To(gwapiv1.PathMatchPathPrefix),
+									Value: ptr.To("/"),
+								},
+							},
+						},
+						BackendRefs: []gwapiv1.HTTPBackendRef{
+							{
+								BackendRef: gwapiv1.BackendRef{
+									BackendObjectReference: gwapiv1.BackendObjectReference{
+										Name: "service-1",
+										Port: ptr.To(gwapiv1.PortNumber(8080)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return resources
+}
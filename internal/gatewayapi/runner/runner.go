@@ -156,10 +156,12 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 				}
 
 				// If an extension is loaded, pass its supported groups/kinds to the translator
-				if r.EnvoyGateway.ExtensionManager != nil {
+				if extensionManagers := r.EnvoyGateway.GetExtensionManagers(); len(extensionManagers) > 0 {
 					var extGKs []schema.GroupKind
-					for _, gvk := range r.EnvoyGateway.ExtensionManager.Resources {
-						extGKs = append(extGKs, schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind})
+					for _, extensionManager := range extensionManagers {
+						for _, gvk := range extensionManager.Resources {
+							extGKs = append(extGKs, schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind})
+						}
 					}
 					t.ExtensionGroupKinds = extGKs
 					r.Logger.Info("extension resources", "GVKs count", len(extGKs))
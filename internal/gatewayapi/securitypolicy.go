@@ -86,94 +86,121 @@ func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.Security
 	handledPolicies := make(map[types.NamespacedName]*egv1a1.SecurityPolicy)
 
 	// Translate
-	// 1. First translate Policies targeting xRoutes
-	// 2. Finally, the policies targeting Gateways
+	// 1. First translate Policies targeting route rules
+	// 2. Then the policies targeting whole xRoutes, which skip the route rules
+	//    that are already configured
+	// 3. Finally, the policies targeting Gateways
+
+	// Process the policies targeting route rules, then the policies targeting xRoutes
+	for _, targetRouteRules := range []bool{true, false} {
+		for _, currPolicy := range securityPolicies {
+			policyName := utils.NamespacedName(currPolicy)
+			targetRefs := getPolicyTargetRefs(currPolicy.Spec.PolicyTargetReferences, routes)
+			for _, currTarget := range targetRefs {
+				if currTarget.Kind != resource.KindGateway && (currTarget.SectionName != nil) == targetRouteRules {
+					var (
+						targetedRoute  RouteContext
+						parentGateways []gwapiv1a2.ParentReference
+						resolveErr     *status.PolicyResolveError
+					)
+					policy, found := handledPolicies[policyName]
+					if !found {
+						policy = currPolicy.DeepCopy()
+						handledPolicies[policyName] = policy
+						res = append(res, policy)
+					}
 
-	// Process the policies targeting xRoutes
-	for _, currPolicy := range securityPolicies {
-		policyName := utils.NamespacedName(currPolicy)
-		targetRefs := getPolicyTargetRefs(currPolicy.Spec.PolicyTargetReferences, routes)
-		for _, currTarget := range targetRefs {
-			if currTarget.Kind != resource.KindGateway {
-				var (
-					targetedRoute  RouteContext
-					parentGateways []gwapiv1a2.ParentReference
-					resolveErr     *status.PolicyResolveError
-				)
-				policy, found := handledPolicies[policyName]
-				if !found {
-					policy = currPolicy.DeepCopy()
-					handledPolicies[policyName] = policy
-					res = append(res, policy)
-				}
+					targetedRoute, resolveErr = resolveSecurityPolicyRouteTargetRef(policy, currTarget, routeMap)
+					// Skip if the route is not found
+					// It's not necessarily an error because the SecurityPolicy may be
+					// reconciled by multiple controllers. And the other controller may
+					// have the target route.
+					if targetedRoute == nil {
+						continue
+					}
 
-				targetedRoute, resolveErr = resolveSecurityPolicyRouteTargetRef(policy, currTarget, routeMap)
-				// Skip if the route is not found
-				// It's not necessarily an error because the SecurityPolicy may be
-				// reconciled by multiple controllers. And the other controller may
-				// have the target route.
-				if targetedRoute == nil {
-					continue
-				}
+					// Find the parent Gateways for the route and add it to the
+					// gatewayRouteMap, which will be used to check policy override.
+					// The parent gateways are also used to set the status of the policy.
+					parentRefs := GetParentReferences(targetedRoute)
+					for _, p := range parentRefs {
+						if p.Kind == nil || *p.Kind == resource.KindGateway {
+							namespace := targetedRoute.GetNamespace()
+							if p.Namespace != nil {
+								namespace = string(*p.Namespace)
+							}
+							gwNN := types.NamespacedName{
+								Namespace: namespace,
+								Name:      string(p.Name),
+							}
 
-				// Find the parent Gateways for the route and add it to the
-				// gatewayRouteMap, which will be used to check policy override.
-				// The parent gateways are also used to set the status of the policy.
-				parentRefs := GetParentReferences(targetedRoute)
-				for _, p := range parentRefs {
-					if p.Kind == nil || *p.Kind == resource.KindGateway {
-						namespace := targetedRoute.GetNamespace()
-						if p.Namespace != nil {
-							namespace = string(*p.Namespace)
-						}
-						gwNN := types.NamespacedName{
-							Namespace: namespace,
-							Name:      string(p.Name),
+							key := gwNN.String()
+							if _, ok := gatewayRouteMap[key]; !ok {
+								gatewayRouteMap[key] = make(sets.Set[string])
+							}
+							gatewayRouteMap[key].Insert(utils.NamespacedName(targetedRoute).String())
+							parentGateways = append(parentGateways, getAncestorRefForPolicy(gwNN, p.SectionName))
 						}
+					}
 
-						key := gwNN.String()
-						if _, ok := gatewayRouteMap[key]; !ok {
-							gatewayRouteMap[key] = make(sets.Set[string])
-						}
-						gatewayRouteMap[key].Insert(utils.NamespacedName(targetedRoute).String())
-						parentGateways = append(parentGateways, getAncestorRefForPolicy(gwNN, p.SectionName))
+					// Set conditions for resolve error, then skip current xroute
+					if resolveErr != nil {
+						status.SetResolveErrorForPolicyAncestors(&policy.Status,
+							parentGateways,
+							t.GatewayControllerName,
+							policy.Generation,
+							resolveErr,
+						)
+
+						continue
 					}
-				}
 
-				// Set conditions for resolve error, then skip current xroute
-				if resolveErr != nil {
-					status.SetResolveErrorForPolicyAncestors(&policy.Status,
-						parentGateways,
-						t.GatewayControllerName,
-						policy.Generation,
-						resolveErr,
-					)
+					if err := validateSecurityPolicy(policy); err != nil {
+						status.SetTranslationErrorForPolicyAncestors(&policy.Status,
+							parentGateways,
+							t.GatewayControllerName,
+							policy.Generation,
+							status.Error2ConditionMsg(fmt.Errorf("invalid SecurityPolicy: %w", err)),
+						)
 
-					continue
-				}
+						continue
+					}
 
-				if err := validateSecurityPolicy(policy); err != nil {
-					status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-						parentGateways,
-						t.GatewayControllerName,
-						policy.Generation,
-						status.Error2ConditionMsg(fmt.Errorf("invalid SecurityPolicy: %w", err)),
-					)
+					if err := t.translateSecurityPolicyForRoute(policy, targetedRoute, currTarget.SectionName, resources, xdsIR); err != nil {
+						status.SetTranslationErrorForPolicyAncestors(&policy.Status,
+							parentGateways,
+							t.GatewayControllerName,
+							policy.Generation,
+							status.Error2ConditionMsg(err),
+						)
+					}
 
-					continue
-				}
+					// Set Accepted condition if it is unset
+					status.SetAcceptedForPolicyAncestors(&policy.Status, parentGateways, t.GatewayControllerName)
 
-				if err := t.translateSecurityPolicyForRoute(policy, targetedRoute, resources, xdsIR); err != nil {
-					status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-						parentGateways,
-						t.GatewayControllerName,
-						policy.Generation,
-						status.Error2ConditionMsg(err),
-					)
+					// Check if this policy is overridden by other policies targeting at
+					// route rule level
+					if currTarget.SectionName == nil {
+						key := policyTargetRouteKey{
+							Kind:      string(currTarget.Kind),
+							Name:      string(currTarget.Name),
+							Namespace: policy.Namespace,
+						}
+						if rules := routeMap[key].attachedToRouteRules; rules.Len() > 0 {
+							message := fmt.Sprintf("This policy is being overridden by other securityPolicies for these route rules: %v", sets.List(rules))
+
+							status.SetConditionForPolicyAncestors(&policy.Status,
+								parentGateways,
+								t.GatewayControllerName,
+								egv1a1.PolicyConditionOverridden,
+								metav1.ConditionTrue,
+								egv1a1.PolicyReasonOverridden,
+								message,
+								policy.Generation,
+							)
+						}
+					}
 				}
-
-				// Set Accepted condition if it is unset
-				status.SetAcceptedForPolicyAncestors(&policy.Status, parentGateways, t.GatewayControllerName)
 			}
 		}
 	}
@@ -344,6 +371,11 @@ func resolveSecurityPolicyRouteTargetRef(
 		return nil, nil
 	}
 
+	// Check if the route rule exists and if another policy targeting the same rule exists
+	if target.SectionName != nil {
+		return route.RouteContext, resolveRouteRuleTargetRef(resource.KindSecurityPolicy, route, *target.SectionName)
+	}
+
 	// Check if another policy targeting the same xRoute exists
 	if route.attached {
 		message := fmt.Sprintf("Unable to target %s %s, another SecurityPolicy has already attached to it",
@@ -363,7 +395,7 @@ func resolveSecurityPolicyRouteTargetRef(
 }
 
 func (t *Translator) translateSecurityPolicyForRoute(
-	policy *egv1a1.SecurityPolicy, route RouteContext,
+	policy *egv1a1.SecurityPolicy, route RouteContext, sectionName *gwapiv1.SectionName,
 	resources *resource.Resources, xdsIR resource.XdsIRMap,
 ) error {
 	// Build IR
@@ -451,7 +483,13 @@ func (t *Translator) translateSecurityPolicyForRoute(
 			irListener := xdsIR[irKey].GetHTTPListener(irListenerName(listener))
 			if irListener != nil {
 				for _, r := range irListener.Routes {
-					if strings.HasPrefix(r.Name, prefix) {
+					if strings.HasPrefix(r.Name, prefix) && irRouteMatchesRouteRule(r, sectionName) {
+						// A policy targeting a route rule takes precedence over a
+						// policy targeting the whole xRoute
+						if sectionName == nil && r.Security != nil {
+							continue
+						}
+
 						r.Security = &ir.SecurityFeatures{
 							CORS:          cors,
 							JWT:           jwt,
@@ -1118,12 +1156,47 @@ func (t *Translator) buildBasicAuth(
 			usersSecret.Namespace, usersSecret.Name)
 	}
 
+	if err = validateHtpasswd(usersSecretBytes); err != nil {
+		return nil, fmt.Errorf(
+			"invalid users in secret %s/%s: %w",
+			usersSecret.Namespace, usersSecret.Name, err)
+	}
+
 	return &ir.BasicAuth{
 		Name:  irConfigName(policy),
 		Users: usersSecretBytes,
 	}, nil
 }
 
+// validateHtpasswd checks the users of the htpasswd file the same way as the basic_auth filter of Envoy,
+// which rejects the whole listener configuration if the file is invalid.
+// Only the SHA hashes are supported, and the empty lines and the comments are ignored.
+func validateHtpasswd(htpasswd []byte) error {
+	users := sets.New[string]()
+	for i, line := range strings.Split(string(htpasswd), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, hash, found := strings.Cut(line, ":")
+		switch {
+		case !found:
+			return fmt.Errorf("line %d: username:password is expected", i+1)
+		case name == "" || hash == "":
+			return fmt.Errorf("line %d: empty user name or password", i+1)
+		case users.Has(name):
+			return fmt.Errorf("line %d: duplicate user %s", i+1, name)
+		case !strings.HasPrefix(hash, "{SHA}"):
+			return fmt.Errorf("line %d: unsupported hashing algorithm for user %s, only {SHA} is supported", i+1, name)
+		// The base64 encoded SHA1 hash is 28 bytes long.
+		case len(strings.TrimPrefix(hash, "{SHA}")) != 28:
+			return fmt.Errorf("line %d: invalid SHA hash length for user %s", i+1, name)
+		}
+		users.Insert(name)
+	}
+	return nil
+}
+
 func (t *Translator) buildExtAuth(
 	policy *egv1a1.SecurityPolicy,
 	resources *resource.Resources,
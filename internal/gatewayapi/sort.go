@@ -76,7 +76,17 @@ func (x XdsIRRoutes) Less(i, j int) bool {
 	// 4. Sort based on the number of Query param matches.
 	qCountI := len(x[i].QueryParamMatches)
 	qCountJ := len(x[j].QueryParamMatches)
-	return qCountI < qCountJ
+	if qCountI < qCountJ {
+		return true
+	}
+	if qCountI > qCountJ {
+		return false
+	}
+	// Equal case
+
+	// 5. Sort based on the presence of a body match, the routes matching the
+	// body must be evaluated before the routes that don't.
+	return x[i].BodyMatch == nil && x[j].BodyMatch != nil
 }
 
 // sortXdsIR sorts the xdsIR based on the match precedence
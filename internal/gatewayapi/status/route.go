@@ -6,12 +6,54 @@
 package status
 
 import (
+	"errors"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+const (
+	// RouteReasonInvalidTimeout is used when a timeout of a route rule can't be parsed.
+	RouteReasonInvalidTimeout gwapiv1.RouteConditionReason = "InvalidTimeout"
+	// RouteReasonInvalidRegex is used when a regular expression of a route rule isn't a valid RE2 regular expression.
+	RouteReasonInvalidRegex gwapiv1.RouteConditionReason = "InvalidRegex"
+	// RouteReasonUnsupportedSessionPersistence is used when the session persistence of a route rule isn't supported.
+	RouteReasonUnsupportedSessionPersistence gwapiv1.RouteConditionReason = "UnsupportedSessionPersistence"
+	// RouteReasonInvalidFilter is used when a filter of a route rule is invalid.
+	RouteReasonInvalidFilter gwapiv1.RouteConditionReason = "InvalidFilter"
+)
+
+// RouteRuleError is an error of the translation of a route rule, with the reason
+// set on the Accepted condition of the route.
+type RouteRuleError struct {
+	Reason gwapiv1.RouteConditionReason
+
+	error
+}
+
+// NewRouteRuleError wraps err with the reason of the Accepted condition of the route.
+func NewRouteRuleError(reason gwapiv1.RouteConditionReason, err error) *RouteRuleError {
+	return &RouteRuleError{
+		Reason: reason,
+		error:  err,
+	}
+}
+
+func (e *RouteRuleError) Unwrap() error {
+	return e.error
+}
+
+// RouteRuleErrorReason returns the reason of the Accepted condition of the route for err,
+// or UnsupportedValue if err isn't a RouteRuleError.
+func RouteRuleErrorReason(err error) gwapiv1.RouteConditionReason {
+	var ruleErr *RouteRuleError
+	if errors.As(err, &ruleErr) {
+		return ruleErr.Reason
+	}
+	return gwapiv1.RouteReasonUnsupportedValue
+}
+
 func SetRouteStatusCondition(route *gwapiv1.RouteStatus, routeParentStatusIdx int, routeGeneration int64,
 	conditionType gwapiv1.RouteConditionType, status metav1.ConditionStatus, reason gwapiv1.RouteConditionReason, message string,
 ) {
@@ -124,3 +124,20 @@ func validateCertificate(data []byte) error {
 	}
 	return nil
 }
+
+func validateCrl(data []byte) error {
+	found := false
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "X509 CRL" {
+			return fmt.Errorf("unexpected pem block type %s", block.Type)
+		}
+		if _, err := x509.ParseRevocationList(block.Bytes); err != nil {
+			return err
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("pem decode failed")
+	}
+	return nil
+}
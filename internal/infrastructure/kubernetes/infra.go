@@ -18,6 +18,7 @@ import (
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
 )
@@ -58,6 +59,10 @@ type Infra struct {
 
 	// Client wrap k8s client.
 	Client *InfraClient
+
+	// ExtensionManager holds the config for interacting with extensions when generating
+	// the proxy infrastructure resources.
+	ExtensionManager extTypes.Manager
 }
 
 // NewInfra returns a new Infra.
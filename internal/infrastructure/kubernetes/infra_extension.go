@@ -0,0 +1,148 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
+)
+
+var _ ResourceRender = &extensionResourceRender{}
+
+// extensionResourceRender wraps a ResourceRender and passes every rendered resource
+// to the extension Infrastructure Resources hook before it is applied.
+type extensionResourceRender struct {
+	ResourceRender
+	hookClient extTypes.InfraHookClient
+	failOpen   bool
+}
+
+// withExtensionHooks wraps the ResourceRender with the extension Infrastructure Resources hook,
+// if an extension is loaded that makes use of it.
+func (i *Infra) withExtensionHooks(r ResourceRender) (ResourceRender, error) {
+	if i.ExtensionManager == nil {
+		return r, nil
+	}
+
+	hookClient, err := i.ExtensionManager.GetPostInfraHookClient(egv1a1.InfrastructureResources)
+	if err != nil {
+		return nil, err
+	}
+	if hookClient == nil {
+		return r, nil
+	}
+
+	return &extensionResourceRender{
+		ResourceRender: r,
+		hookClient:     hookClient,
+		failOpen:       i.ExtensionManager.FailOpen(),
+	}, nil
+}
+
+func (r *extensionResourceRender) ServiceAccount() (*corev1.ServiceAccount, error) {
+	sa, err := r.ResourceRender.ServiceAccount()
+	if err != nil || sa == nil {
+		return sa, err
+	}
+	return postInfraModify(r, sa)
+}
+
+func (r *extensionResourceRender) Service() (*corev1.Service, error) {
+	svc, err := r.ResourceRender.Service()
+	if err != nil || svc == nil {
+		return svc, err
+	}
+	return postInfraModify(r, svc)
+}
+
+func (r *extensionResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
+	cm, err := r.ResourceRender.ConfigMap()
+	if err != nil || cm == nil {
+		return cm, err
+	}
+	return postInfraModify(r, cm)
+}
+
+func (r *extensionResourceRender) Deployment() (*appsv1.Deployment, error) {
+	deployment, err := r.ResourceRender.Deployment()
+	if err != nil || deployment == nil {
+		return deployment, err
+	}
+	return postInfraModify(r, deployment)
+}
+
+func (r *extensionResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	daemonSet, err := r.ResourceRender.DaemonSet()
+	if err != nil || daemonSet == nil {
+		return daemonSet, err
+	}
+	return postInfraModify(r, daemonSet)
+}
+
+func (r *extensionResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa, err := r.ResourceRender.HorizontalPodAutoscaler()
+	if err != nil || hpa == nil {
+		return hpa, err
+	}
+	return postInfraModify(r, hpa)
+}
+
+func (r *extensionResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	pdb, err := r.ResourceRender.PodDisruptionBudget()
+	if err != nil || pdb == nil {
+		return pdb, err
+	}
+	return postInfraModify(r, pdb)
+}
+
+// postInfraModify sends the resource to the extension and returns the resource modified by it.
+// If the extension returns nil, or returns an error and is configured to fail open, the resource
+// is returned unmodified.
+func postInfraModify[T client.Object](r *extensionResourceRender, obj T) (T, error) {
+	var zero T
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return zero, err
+	}
+
+	modified, err := r.hookClient.PostInfraModifyHook(&unstructured.Unstructured{Object: content})
+	if err != nil {
+		if r.failOpen {
+			return obj, nil
+		}
+		return zero, fmt.Errorf("extension failed to modify %s %s: %w",
+			obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+	if modified == nil {
+		return obj, nil
+	}
+
+	// The extension is not allowed to change the identity of the resource, since the infrastructure
+	// manager relies on it to update and delete the resource.
+	if modified.GetName() != obj.GetName() || modified.GetNamespace() != obj.GetNamespace() ||
+		modified.GroupVersionKind() != obj.GetObjectKind().GroupVersionKind() {
+		return zero, fmt.Errorf("extension changed the identity of %s %s/%s",
+			obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName())
+	}
+
+	out := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(T)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(modified.Object, out); err != nil {
+		return zero, err
+	}
+	return out, nil
+}
@@ -0,0 +1,102 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fakeInfraHook adds a label to every resource it receives.
+type fakeInfraHook struct {
+	err    error
+	rename bool
+}
+
+func (f *fakeInfraHook) PostInfraModifyHook(resource *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := resource.DeepCopy()
+	out.SetLabels(map[string]string{"modified-by": "extension"})
+	if f.rename {
+		out.SetName("renamed")
+	}
+	return out, nil
+}
+
+// fakeDeploymentRender only renders a Deployment.
+type fakeDeploymentRender struct {
+	ResourceRender
+}
+
+func (r *fakeDeploymentRender) Deployment() (*appsv1.Deployment, error) {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "envoy-gateway-system",
+			Name:      "envoy-default-gateway",
+		},
+	}, nil
+}
+
+func TestExtensionResourceRender(t *testing.T) {
+	testCases := []struct {
+		name      string
+		hook      *fakeInfraHook
+		failOpen  bool
+		expectErr bool
+		expect    map[string]string
+	}{
+		{
+			name:   "modified by extension",
+			hook:   &fakeInfraHook{},
+			expect: map[string]string{"modified-by": "extension"},
+		},
+		{
+			name:      "extension error",
+			hook:      &fakeInfraHook{err: errors.New("unavailable")},
+			expectErr: true,
+		},
+		{
+			name:     "extension error with fail open",
+			hook:     &fakeInfraHook{err: errors.New("unavailable")},
+			failOpen: true,
+		},
+		{
+			name:      "extension changes resource name",
+			hook:      &fakeInfraHook{rename: true},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &extensionResourceRender{
+				ResourceRender: &fakeDeploymentRender{},
+				hookClient:     tc.hook,
+				failOpen:       tc.failOpen,
+			}
+
+			deployment, err := r.Deployment()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "envoy-default-gateway", deployment.Name)
+			require.Equal(t, tc.expect, deployment.Labels)
+		})
+	}
+}
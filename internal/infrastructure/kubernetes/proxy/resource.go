@@ -325,6 +325,15 @@ func expectedVolumes(name string, pod *egv1a1.KubernetesPodSpec) []corev1.Volume
 	return resource.ExpectedVolumes(pod, volumes)
 }
 
+// expectedDNSPolicy returns the DNS policy of the pod, which must be
+// ClusterFirstWithHostNet to resolve the cluster names in the host network.
+func expectedDNSPolicy(pod *egv1a1.KubernetesPodSpec) corev1.DNSPolicy {
+	if ptr.Deref(pod.HostNetwork, false) {
+		return corev1.DNSClusterFirstWithHostNet
+	}
+	return corev1.DNSClusterFirst
+}
+
 // expectedContainerEnv returns expected proxy container envs.
 func expectedContainerEnv(containerSpec *egv1a1.KubernetesContainerSpec) []corev1.EnvVar {
 	env := []corev1.EnvVar{
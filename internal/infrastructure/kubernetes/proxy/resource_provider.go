@@ -316,7 +316,8 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 					ServiceAccountName:            r.Name(),
 					AutomountServiceAccountToken:  ptr.To(false),
 					TerminationGracePeriodSeconds: expectedTerminationGracePeriodSeconds(proxyConfig.Spec.Shutdown),
-					DNSPolicy:                     corev1.DNSClusterFirst,
+					HostNetwork:                   ptr.Deref(deploymentConfig.Pod.HostNetwork, false),
+					DNSPolicy:                     expectedDNSPolicy(deploymentConfig.Pod),
 					RestartPolicy:                 corev1.RestartPolicyAlways,
 					SchedulerName:                 "default-scheduler",
 					SecurityContext:               deploymentConfig.Pod.SecurityContext,
@@ -555,7 +556,8 @@ func (r *ResourceRender) getPodSpec(
 		ServiceAccountName:            ExpectedResourceHashedName(r.infra.Name),
 		AutomountServiceAccountToken:  ptr.To(false),
 		TerminationGracePeriodSeconds: expectedTerminationGracePeriodSeconds(proxyConfig.Spec.Shutdown),
-		DNSPolicy:                     corev1.DNSClusterFirst,
+		HostNetwork:                   ptr.Deref(pod.HostNetwork, false),
+		DNSPolicy:                     expectedDNSPolicy(pod),
 		RestartPolicy:                 corev1.RestartPolicyAlways,
 		SchedulerName:                 "default-scheduler",
 		SecurityContext:               pod.SecurityContext,
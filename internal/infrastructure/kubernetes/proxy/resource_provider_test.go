@@ -516,6 +516,15 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "with-host-network",
+			infra:    newTestInfra(),
+			deploy: &egv1a1.KubernetesDeploymentSpec{
+				Pod: &egv1a1.KubernetesPodSpec{
+					HostNetwork: ptr.To(true),
+				},
+			},
+		},
 		{
 			caseName: "with-topology-spread-constraints",
 			infra:    newTestInfra(),
@@ -959,6 +968,15 @@ func TestDaemonSet(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "with-host-network",
+			infra:    newTestInfra(),
+			daemonset: &egv1a1.KubernetesDaemonSetSpec{
+				Pod: &egv1a1.KubernetesPodSpec{
+					HostNetwork: ptr.To(true),
+				},
+			},
+		},
 		{
 			caseName: "with-topology-spread-constraints",
 			infra:    newTestInfra(),
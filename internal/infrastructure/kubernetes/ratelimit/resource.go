@@ -272,6 +272,15 @@ func expectedDeploymentVolumes(rateLimit *egv1a1.RateLimit, rateLimitDeployment
 	return resource.ExpectedVolumes(rateLimitDeployment.Pod, volumes)
 }
 
+// expectedDNSPolicy returns the DNS policy of the pod, which must be
+// ClusterFirstWithHostNet to resolve the cluster names in the host network.
+func expectedDNSPolicy(pod *egv1a1.KubernetesPodSpec) corev1.DNSPolicy {
+	if ptr.Deref(pod.HostNetwork, false) {
+		return corev1.DNSClusterFirstWithHostNet
+	}
+	return corev1.DNSClusterFirst
+}
+
 // expectedRateLimitContainerEnv returns expected rateLimit container envs.
 func expectedRateLimitContainerEnv(rateLimit *egv1a1.RateLimit, rateLimitDeployment *egv1a1.KubernetesDeploymentSpec,
 	namespace string,
@@ -253,7 +253,8 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 					ServiceAccountName:            InfraName,
 					AutomountServiceAccountToken:  ptr.To(false),
 					TerminationGracePeriodSeconds: ptr.To[int64](300),
-					DNSPolicy:                     corev1.DNSClusterFirst,
+					HostNetwork:                   ptr.Deref(r.rateLimitDeployment.Pod.HostNetwork, false),
+					DNSPolicy:                     expectedDNSPolicy(r.rateLimitDeployment.Pod),
 					RestartPolicy:                 corev1.RestartPolicyAlways,
 					SchedulerName:                 "default-scheduler",
 					SecurityContext:               r.rateLimitDeployment.Pod.SecurityContext,
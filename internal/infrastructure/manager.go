@@ -15,6 +15,7 @@ import (
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/infrastructure/host"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -39,10 +40,10 @@ type Manager interface {
 }
 
 // NewManager returns a new infrastructure Manager.
-func NewManager(ctx context.Context, cfg *config.Server, logger logging.Logger) (mgr Manager, err error) {
+func NewManager(ctx context.Context, cfg *config.Server, extensionManager extTypes.Manager, logger logging.Logger) (mgr Manager, err error) {
 	switch cfg.EnvoyGateway.Provider.Type {
 	case egv1a1.ProviderTypeKubernetes:
-		mgr, err = newManagerForKubernetes(cfg)
+		mgr, err = newManagerForKubernetes(cfg, extensionManager)
 	case egv1a1.ProviderTypeCustom:
 		mgr, err = newManagerForCustom(ctx, cfg, logger)
 	}
@@ -53,12 +54,14 @@ func NewManager(ctx context.Context, cfg *config.Server, logger logging.Logger)
 	return mgr, nil
 }
 
-func newManagerForKubernetes(cfg *config.Server) (Manager, error) {
+func newManagerForKubernetes(cfg *config.Server, extensionManager extTypes.Manager) (Manager, error) {
 	cli, err := client.New(clicfg.GetConfigOrDie(), client.Options{Scheme: envoygateway.GetScheme()})
 	if err != nil {
 		return nil, err
 	}
-	return kubernetes.NewInfra(cli, cfg), nil
+	infra := kubernetes.NewInfra(cli, cfg)
+	infra.ExtensionManager = extensionManager
+	return infra, nil
 }
 
 func newManagerForCustom(ctx context.Context, cfg *config.Server, logger logging.Logger) (Manager, error) {
@@ -12,6 +12,7 @@ import (
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/infrastructure"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
@@ -19,7 +20,8 @@ import (
 
 type Config struct {
 	config.Server
-	InfraIR *message.InfraIR
+	InfraIR          *message.InfraIR
+	ExtensionManager extTypes.Manager
 }
 
 type Runner struct {
@@ -44,7 +46,7 @@ func (r *Runner) Start(ctx context.Context) (err error) {
 		return nil
 	}
 
-	r.mgr, err = infrastructure.NewManager(ctx, &r.Config.Server, r.Logger)
+	r.mgr, err = infrastructure.NewManager(ctx, &r.Config.Server, r.ExtensionManager, r.Logger)
 	if err != nil {
 		r.Logger.Error(err, "failed to create new manager")
 		return err
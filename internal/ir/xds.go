@@ -298,6 +298,8 @@ type HTTPListener struct {
 	Headers *HeaderSettings `json:"headers,omitempty" yaml:"headers,omitempty"`
 	// EnableProxyProtocol enables the listener to interpret proxy protocol header
 	EnableProxyProtocol bool `json:"enableProxyProtocol,omitempty" yaml:"enableProxyProtocol,omitempty"`
+	// OptionalProxyProtocol allows the connections without a proxy protocol header
+	OptionalProxyProtocol bool `json:"optionalProxyProtocol,omitempty" yaml:"optionalProxyProtocol,omitempty"`
 	// ClientIPDetection controls how the original client IP address is determined for requests.
 	ClientIPDetection *ClientIPDetectionSettings `json:"clientIPDetection,omitempty" yaml:"clientIPDetection,omitempty"`
 	// Path contains settings for path URI manipulations
@@ -313,6 +315,8 @@ type HTTPListener struct {
 	HTTP3 *HTTP3Settings `json:"http3,omitempty"`
 	// HealthCheck provides configuration for determining whether the HTTP/HTTPS listener is healthy.
 	HealthCheck *HealthCheckSettings `json:"healthCheck,omitempty" yaml:"healthCheck,omitempty"`
+	// IPTagging tags the requests based on the IP address of the client.
+	IPTagging *IPTagging `json:"ipTagging,omitempty" yaml:"ipTagging,omitempty"`
 	// ClientTimeout sets the timeout configuration for downstream connections
 	Timeout *ClientTimeout `json:"timeout,omitempty" yaml:"clientTimeout,omitempty"`
 	// Connection settings
@@ -401,6 +405,8 @@ type TLSConfig struct {
 	CACertificate *TLSCACertificate `json:"caCertificate,omitempty" yaml:"caCertificate,omitempty"`
 	// RequireClientCertificate to enforce client certificate
 	RequireClientCertificate bool `json:"requireClientCertificate,omitempty" yaml:"requireClientCertificate,omitempty"`
+	// Crl to check the revocation of the client certificates
+	Crl *TLSCrl `json:"crl,omitempty" yaml:"crl,omitempty"`
 	// MinVersion defines the minimal version of the TLS protocol supported by this listener.
 	MinVersion *TLSVersion `json:"minVersion,omitempty" yaml:"version,omitempty"`
 	// MaxVersion defines the maximal version of the TLS protocol supported by this listener.
@@ -417,6 +423,8 @@ type TLSConfig struct {
 	StatelessSessionResumption bool `json:"statelessSessionResumption,omitempty" yaml:"statelessSessionResumption,omitempty"`
 	// StatefulSessionResumption determines if stateful (session-id based) session resumption is enabled
 	StatefulSessionResumption bool `json:"statefulSessionResumption,omitempty" yaml:"statefulSessionResumption,omitempty"`
+	// SessionTicketKeys to encrypt and decrypt the session tickets of the stateless session resumption
+	SessionTicketKeys *TLSSessionTicketKeys `json:"sessionTicketKeys,omitempty" yaml:"sessionTicketKeys,omitempty"`
 }
 
 // TLSCertificate holds a single certificate's details
@@ -439,6 +447,26 @@ type TLSCACertificate struct {
 	Certificate []byte `json:"certificate,omitempty" yaml:"certificate,omitempty"`
 }
 
+// TLSSessionTicketKeys holds the keys to encrypt and decrypt the TLS session tickets
+// +k8s:deepcopy-gen=true
+type TLSSessionTicketKeys struct {
+	// Name of the xDS Secret.
+	Name string `json:"name" yaml:"name"`
+	// Keys are the session ticket keys, the first key encrypts the new session tickets.
+	Keys []PrivateBytes `json:"keys,omitempty" yaml:"keys,omitempty"`
+}
+
+// TLSCrl holds the certificate revocation lists to check the client certificates
+// +k8s:deepcopy-gen=true
+type TLSCrl struct {
+	// Name of the Secret object.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Data is the content of the certificate revocation lists.
+	Data []byte `json:"data,omitempty" yaml:"data,omitempty"`
+	// OnlyVerifyLeafCertificate only checks the leaf certificate against the certificate revocation lists.
+	OnlyVerifyLeafCertificate bool `json:"onlyVerifyLeafCertificate,omitempty" yaml:"onlyVerifyLeafCertificate,omitempty"`
+}
+
 func (t TLSCertificate) Validate() error {
 	var errs error
 	if len(t.Certificate) == 0 {
@@ -535,6 +563,12 @@ type HTTP2Settings struct {
 	InitialConnectionWindowSize *uint32 `json:"initialStreamWindowSize,omitempty" yaml:"initialStreamWindowSize,omitempty"`
 	// MaxConcurrentStreams is the maximum number of concurrent streams that can be opened on a connection.
 	MaxConcurrentStreams *uint32 `json:"maxConcurrentStreams,omitempty" yaml:"maxConcurrentStreams,omitempty"`
+	// MaxOutboundFrames is the maximum number of pending outbound frames on a connection.
+	MaxOutboundFrames *uint32 `json:"maxOutboundFrames,omitempty" yaml:"maxOutboundFrames,omitempty"`
+	// MaxOutboundControlFrames is the maximum number of pending outbound control frames on a connection.
+	MaxOutboundControlFrames *uint32 `json:"maxOutboundControlFrames,omitempty" yaml:"maxOutboundControlFrames,omitempty"`
+	// MaxConsecutiveInboundFramesWithEmptyPayload is the maximum number of consecutive inbound frames with an empty payload.
+	MaxConsecutiveInboundFramesWithEmptyPayload *uint32 `json:"maxConsecutiveInboundFramesWithEmptyPayload,omitempty" yaml:"maxConsecutiveInboundFramesWithEmptyPayload,omitempty"`
 	// ResetStreamOnError determines if a stream or connection is reset on messaging error.
 	ResetStreamOnError *bool `json:"resetStreamOnError,omitempty" yaml:"resetStreamOnError,omitempty"`
 }
@@ -565,7 +599,11 @@ type ResponseOverrideRule struct {
 // +k8s:deepcopy-gen=true
 type CustomResponseMatch struct {
 	// Status code to match on. The match evaluates to true if any of the matches are successful.
-	StatusCodes []StatusCodeMatch `json:"statusCodes"`
+	StatusCodes []StatusCodeMatch `json:"statusCodes,omitempty"`
+
+	// Failures to match on. The match evaluates to true if the response was generated by Envoy
+	// because of any of the failures.
+	Failures []egv1a1.ResponseFailureType `json:"failures,omitempty"`
 }
 
 // StatusCodeMatch defines the configuration for matching a status code.
@@ -597,6 +635,9 @@ type CustomResponse struct {
 
 	// StatusCode will be used for the response's status code.
 	StatusCode *uint32 `json:"statusCode,omitempty"`
+
+	// Templated enables the substitution of the Envoy command operators in the body.
+	Templated bool `json:"templated,omitempty"`
 }
 
 // Validate the fields within the CustomResponse structure
@@ -613,6 +654,24 @@ func (r *CustomResponse) Validate() error {
 // +k8s:deepcopy-gen=true
 type HealthCheckSettings egv1a1.HealthCheckSettings
 
+// IPTagging holds the configuration to tag the requests based on the IP address of the client.
+// +k8s:deepcopy-gen=true
+type IPTagging struct {
+	// Header is the name of the request header the tags are set in.
+	Header string `json:"header" yaml:"header"`
+	// Tags are the tags and the CIDR ranges of the clients they are applied to.
+	Tags []*IPTag `json:"tags" yaml:"tags"`
+}
+
+// IPTag holds a tag applied to the requests of the clients in the CIDR ranges.
+// +k8s:deepcopy-gen=true
+type IPTag struct {
+	// Name of the tag.
+	Name string `json:"name" yaml:"name"`
+	// CIDRs are the IP CIDR ranges of the clients the tag is applied to.
+	CIDRs []*CIDRMatch `json:"cidrs" yaml:"cidrs"`
+}
+
 // HeaderSettings provides configuration related to header processing on the listener.
 // +k8s:deepcopy-gen=true
 type HeaderSettings struct {
@@ -673,6 +732,8 @@ type HTTPClientTimeout struct {
 	RequestReceivedTimeout *metav1.Duration `json:"requestReceivedTimeout,omitempty" yaml:"requestReceivedTimeout,omitempty"`
 	// IdleTimeout for an HTTP connection. Idle time is defined as a period in which there are no active requests in the connection.
 	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+	// StreamIdleTimeout for an HTTP stream. Idle time is defined as a period in which no bytes are sent or received on the stream.
+	StreamIdleTimeout *metav1.Duration `json:"streamIdleTimeout,omitempty" yaml:"streamIdleTimeout,omitempty"`
 }
 
 // HTTPRoute holds the route information associated with the HTTP Route
@@ -690,6 +751,12 @@ type HTTPRoute struct {
 	HeaderMatches []*StringMatch `json:"headerMatches,omitempty" yaml:"headerMatches,omitempty"`
 	// QueryParamMatches define the match conditions on the query parameters.
 	QueryParamMatches []*StringMatch `json:"queryParamMatches,omitempty" yaml:"queryParamMatches,omitempty"`
+	// BodyMatch defines the match condition on a field of the JSON request body.
+	// The name of the match is the path of the field, with the keys separated by dots.
+	BodyMatch *StringMatch `json:"bodyMatch,omitempty" yaml:"bodyMatch,omitempty"`
+	// ConnectTermination is set if the route matches the CONNECT requests, and terminates them
+	// to tunnel their payload to the backends over TCP.
+	ConnectTermination bool `json:"connectTermination,omitempty" yaml:"connectTermination,omitempty"`
 	// AddRequestHeaders defines header/value sets to be added to the headers of requests.
 	AddRequestHeaders []AddHeader `json:"addRequestHeaders,omitempty" yaml:"addRequestHeaders,omitempty"`
 	// RemoveRequestHeaders defines a list of headers to be removed from requests.
@@ -698,6 +765,8 @@ type HTTPRoute struct {
 	AddResponseHeaders []AddHeader `json:"addResponseHeaders,omitempty" yaml:"addResponseHeaders,omitempty"`
 	// RemoveResponseHeaders defines a list of headers to be removed from response.
 	RemoveResponseHeaders []string `json:"removeResponseHeaders,omitempty" yaml:"removeResponseHeaders,omitempty"`
+	// CredentialInjection defines the credential injected in the requests sent to the backends.
+	CredentialInjection *CredentialInjection `json:"credentialInjection,omitempty" yaml:"credentialInjection,omitempty"`
 	// Direct responses to be returned for this route. Takes precedence over Destinations and Redirect.
 	DirectResponse *CustomResponse `json:"directResponse,omitempty" yaml:"directResponse,omitempty"`
 	// Redirections to be returned for this route. Takes precedence over Destinations.
@@ -780,8 +849,39 @@ type HeaderBasedSessionPersistence struct {
 // Compression holds the configuration for HTTP compression.
 // +k8s:deepcopy-gen=true
 type Compression struct {
+	// Name is a unique name for the compression configuration.
+	// The xds translator only uses it to generate a dedicated compressor filter
+	// when MinContentLength or ContentTypes are set.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 	// Type of compression to be used.
 	Type egv1a1.CompressorType `json:"type" yaml:"type"`
+	// MinContentLength is the minimum length of the responses to be compressed.
+	MinContentLength *uint32 `json:"minContentLength,omitempty" yaml:"minContentLength,omitempty"`
+	// ContentTypes is the list of the content types of the responses to be compressed.
+	ContentTypes []string `json:"contentTypes,omitempty" yaml:"contentTypes,omitempty"`
+}
+
+// ResponseCache holds the configuration for the HTTP response cache.
+// +k8s:deepcopy-gen=true
+type ResponseCache struct {
+	// Name is a unique name for the response cache configuration.
+	Name string `json:"name" yaml:"name"`
+	// AllowedVaryHeaders is the list of the request headers the cached responses can vary on.
+	AllowedVaryHeaders []string `json:"allowedVaryHeaders,omitempty" yaml:"allowedVaryHeaders,omitempty"`
+	// QueryParams is the list of the query parameters included in the cache key.
+	// If empty, all the query parameters are included.
+	QueryParams []string `json:"queryParams,omitempty" yaml:"queryParams,omitempty"`
+	// TTL overrides the freshness lifetime of the responses.
+	TTL *metav1.Duration `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+}
+
+// HTTPUpgradeConfig holds the configuration of an HTTP protocol upgrade.
+// +k8s:deepcopy-gen=true
+type HTTPUpgradeConfig struct {
+	// Type is the lowercase name of the upgrade protocol.
+	Type string `json:"type" yaml:"type"`
+	// Disabled rejects the upgrades to this protocol.
+	Disabled bool `json:"disabled,omitempty" yaml:"disabled,omitempty"`
 }
 
 // TrafficFeatures holds the information associated with the Backend Traffic Policy.
@@ -817,6 +917,12 @@ type TrafficFeatures struct {
 	ResponseOverride *ResponseOverride `json:"responseOverride,omitempty" yaml:"responseOverride,omitempty"`
 	// Compression settings for HTTP Response
 	Compression []*Compression `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// ResponseCache settings for HTTP Response
+	ResponseCache *ResponseCache `json:"responseCache,omitempty" yaml:"responseCache,omitempty"`
+	// HTTPUpgrade defines the protocol upgrades allowed or rejected on the route.
+	HTTPUpgrade []HTTPUpgradeConfig `json:"httpUpgrade,omitempty" yaml:"httpUpgrade,omitempty"`
+	// Tracing overrides the tracing settings of the listener for the route.
+	Tracing *RouteTracing `json:"tracing,omitempty" yaml:"tracing,omitempty"`
 }
 
 func (b *TrafficFeatures) Validate() error {
@@ -864,6 +970,8 @@ type EnvoyExtensionFeatures struct {
 	Wasms []Wasm `json:"wasms,omitempty" yaml:"wasms,omitempty"`
 	// Lua extensions
 	Luas []Lua `json:"luas,omitempty" yaml:"luas,omitempty"`
+	// gRPC-JSON transcoder extension
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty" yaml:"grpcJSONTranscoder,omitempty"`
 }
 
 // UnstructuredRef holds unstructured data for an arbitrary k8s resource introduced by an extension
@@ -1307,6 +1415,11 @@ func (h *HTTPRoute) Validate() error {
 			errs = errors.Join(errs, err)
 		}
 	}
+	if h.BodyMatch != nil {
+		if err := h.BodyMatch.Validate(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
 	if h.Destination != nil {
 		if err := h.Destination.Validate(); err != nil {
 			errs = errors.Join(errs, err)
@@ -1455,6 +1568,8 @@ type DestinationSetting struct {
 	IPFamily *egv1a1.IPFamily    `json:"ipFamily,omitempty" yaml:"ipFamily,omitempty"`
 	TLS      *TLSUpstreamConfig  `json:"tls,omitempty" yaml:"tls,omitempty"`
 	Filters  *DestinationFilters `json:"filters,omitempty" yaml:"filters,omitempty"`
+	// ProxyProtocol overrides the proxy protocol settings of the route for this destination.
+	ProxyProtocol *ProxyProtocol `json:"proxyProtocol,omitempty" yaml:"proxyProtocol,omitempty"`
 }
 
 // Validate the fields within the DestinationSetting structure
@@ -1528,6 +1643,18 @@ func NewDestEndpoint(host string, port uint32, draining bool) *DestinationEndpoi
 	}
 }
 
+// CredentialInjection defines a credential injected in the requests sent to the backends.
+// +k8s:deepcopy-gen=true
+type CredentialInjection struct {
+	// Name is a unique name for the credential injection configuration.
+	// The xds translator uses it as the name of the filter and of its SDS secret.
+	Name string `json:"name" yaml:"name"`
+	// Header is the name of the request header the credential is injected in.
+	Header string `json:"header" yaml:"header"`
+	// Credential is the value of the injected header.
+	Credential PrivateBytes `json:"credential,omitempty" yaml:"credential,omitempty"`
+}
+
 // AddHeader configures a header to be added to a request or response.
 // +k8s:deepcopy-gen=true
 type AddHeader struct {
@@ -1766,6 +1893,8 @@ type TCPListener struct {
 	TCPKeepalive *TCPKeepalive `json:"tcpKeepalive,omitempty" yaml:"tcpKeepalive,omitempty"`
 	// EnableProxyProtocol enables the listener to interpret proxy protocol header
 	EnableProxyProtocol bool `json:"enableProxyProtocol,omitempty" yaml:"enableProxyProtocol,omitempty"`
+	// OptionalProxyProtocol allows the connections without a proxy protocol header
+	OptionalProxyProtocol bool `json:"optionalProxyProtocol,omitempty" yaml:"optionalProxyProtocol,omitempty"`
 	// ClientTimeout sets the timeout configuration for downstream connections.
 	Timeout *ClientTimeout `json:"timeout,omitempty" yaml:"clientTimeout,omitempty"`
 	// Connection settings for clients
@@ -1799,6 +1928,15 @@ type TCPRoute struct {
 	BackendConnection *BackendConnection `json:"backendConnection,omitempty" yaml:"backendConnection,omitempty"`
 	// DNS is used to configure how DNS resolution is handled for the route
 	DNS *DNS `json:"dns,omitempty" yaml:"dns,omitempty"`
+	// Tunneling tunnels the TCP connections to the backends in HTTP CONNECT requests.
+	Tunneling *TCPTunneling `json:"tunneling,omitempty" yaml:"tunneling,omitempty"`
+}
+
+// TCPTunneling holds the configuration of the tunneling of the TCP connections in HTTP CONNECT requests.
+// +k8s:deepcopy-gen=true
+type TCPTunneling struct {
+	// Hostname is the authority of the CONNECT requests.
+	Hostname string `json:"hostname" yaml:"hostname"`
 }
 
 // TLS holds information for configuring TLS on a listener
@@ -2238,10 +2376,20 @@ type Tracing struct {
 	Provider     egv1a1.TracingProvider      `json:"provider"`
 }
 
+// RouteTracing defines the tracing configuration of a route.
+// +k8s:deepcopy-gen=true
+type RouteTracing struct {
+	// Disable disables the tracing of the route.
+	Disable bool `json:"disable,omitempty" yaml:"disable,omitempty"`
+	// SamplingRate is the percentage of the requests of the route selected for tracing.
+	SamplingRate *uint32 `json:"samplingRate,omitempty" yaml:"samplingRate,omitempty"`
+}
+
 // Metrics defines the configuration for metrics generated by Envoy
 // +k8s:deepcopy-gen=true
 type Metrics struct {
 	EnableVirtualHostStats          bool `json:"enableVirtualHostStats" yaml:"enableVirtualHostStats"`
+	EnableRouteStats                bool `json:"enableRouteStats" yaml:"enableRouteStats"`
 	EnablePerEndpointStats          bool `json:"enablePerEndpointStats" yaml:"enablePerEndpointStats"`
 	EnableRequestResponseSizesStats bool `json:"enableRequestResponseSizesStats" yaml:"enableRequestResponseSizesStats"`
 }
@@ -2312,6 +2460,8 @@ type LeastRequest struct {
 	// SlowStart defines the slow start configuration.
 	// If set, slow start mode is enabled for newly added hosts in the cluster.
 	SlowStart *SlowStart `json:"slowStart,omitempty" yaml:"slowStart,omitempty"`
+	// ChoiceCount is the number of random hosts picked to choose the host with the fewest active requests.
+	ChoiceCount *uint32 `json:"choiceCount,omitempty" yaml:"choiceCount,omitempty"`
 }
 
 // Random load balancer settings
@@ -2322,10 +2472,13 @@ type Random struct{}
 // +k8s:deepcopy-gen=true
 type ConsistentHash struct {
 	// Hash based on the Source IP Address
-	SourceIP  *bool          `json:"sourceIP,omitempty" yaml:"sourceIP,omitempty"`
-	Header    *Header        `json:"header,omitempty" yaml:"header,omitempty"`
-	Cookie    *egv1a1.Cookie `json:"cookie,omitempty" yaml:"cookie,omitempty"`
-	TableSize *uint64        `json:"tableSize,omitempty" yaml:"tableSize,omitempty"`
+	SourceIP       *bool           `json:"sourceIP,omitempty" yaml:"sourceIP,omitempty"`
+	Header         *Header         `json:"header,omitempty" yaml:"header,omitempty"`
+	Cookie         *egv1a1.Cookie  `json:"cookie,omitempty" yaml:"cookie,omitempty"`
+	QueryParameter *QueryParameter `json:"queryParameter,omitempty" yaml:"queryParameter,omitempty"`
+	TableSize      *uint64         `json:"tableSize,omitempty" yaml:"tableSize,omitempty"`
+	// RingHash selects the backend hosts with a hash ring instead of a Maglev lookup table.
+	RingHash bool `json:"ringHash,omitempty" yaml:"ringHash,omitempty"`
 }
 
 // Header consistent hash type settings
@@ -2333,6 +2486,11 @@ type Header struct {
 	Name string `json:"name" yaml:"name"`
 }
 
+// QueryParameter consistent hash type settings
+type QueryParameter struct {
+	Name string `json:"name" yaml:"name"`
+}
+
 type ProxyProtocolVersion string
 
 const (
@@ -2354,6 +2512,8 @@ type ProxyProtocol struct {
 type SlowStart struct {
 	// Window defines the duration of the warm up period for newly added host.
 	Window *metav1.Duration `json:"window" yaml:"window"`
+	// Aggression defines the rate at which the traffic sent to the newly added host increases.
+	Aggression *float32 `json:"aggression,omitempty" yaml:"aggression,omitempty"`
 }
 
 // Backend CircuitBreaker settings for the DEFAULT routing priority
@@ -2639,6 +2799,9 @@ type HTTPTimeout struct {
 
 	// The maximum duration of an HTTP connection.
 	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty" yaml:"maxConnectionDuration,omitempty"`
+
+	// StreamIdleTimeout overrides the stream idle timeout of the listener for the route.
+	StreamIdleTimeout *metav1.Duration `json:"streamIdleTimeout,omitempty" yaml:"streamIdleTimeout,omitempty"`
 }
 
 // Retry define the retry policy configuration.
@@ -2703,7 +2866,18 @@ type TLSUpstreamConfig struct {
 	SNI                 string            `json:"sni,omitempty" yaml:"sni,omitempty"`
 	UseSystemTrustStore bool              `json:"useSystemTrustStore,omitempty" yaml:"useSystemTrustStore,omitempty"`
 	CACertificate       *TLSCACertificate `json:"caCertificate,omitempty" yaml:"caCertificate,omitempty"`
-	TLSConfig           `json:",inline"`
+	// SubjectAltNames are the Subject Alternative Names the certificate of the backend must match.
+	// The SNI is matched as a DNS name when empty.
+	SubjectAltNames []SubjectAltName `json:"subjectAltNames,omitempty" yaml:"subjectAltNames,omitempty"`
+	TLSConfig       `json:",inline"`
+}
+
+// SubjectAltName is a Subject Alternative Name of a certificate.
+// Exactly one of Hostname or URI is set.
+// +k8s:deepcopy-gen=true
+type SubjectAltName struct {
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	URI      *string `json:"uri,omitempty" yaml:"uri,omitempty"`
 }
 
 func (t *TLSUpstreamConfig) ToTLSConfig() (*tls.Config, error) {
@@ -2769,6 +2943,8 @@ const (
 	ExtProcBodyBuffered = ExtProcBodyProcessingMode(egv1a1.BufferedExtProcBodyProcessingMode)
 	// ExtProcBodyBufferedPartial sets the partial buffered body processing mode
 	ExtProcBodyBufferedPartial = ExtProcBodyProcessingMode(egv1a1.BufferedPartialExtBodyHeaderProcessingMode)
+	// ExtProcBodyFullDuplexStreamed sets the full duplex streamed body processing mode
+	ExtProcBodyFullDuplexStreamed = ExtProcBodyProcessingMode(egv1a1.FullDuplexStreamedExtProcBodyProcessingMode)
 )
 
 // ExtProc holds the information associated with the ExtProc extensions.
@@ -2790,6 +2966,9 @@ type ExtProc struct {
 	// MessageTimeout is the timeout for a response to be returned from the external processor
 	MessageTimeout *metav1.Duration `json:"messageTimeout,omitempty" yaml:"messageTimeout,omitempty"`
 
+	// MaxMessageTimeout is the maximum timeout the external processor can request to extend the message timeout
+	MaxMessageTimeout *metav1.Duration `json:"maxMessageTimeout,omitempty" yaml:"maxMessageTimeout,omitempty"`
+
 	// FailOpen defines if requests or responses that cannot be processed due to connectivity to the
 	// external processor are terminated or passed-through.
 	FailOpen *bool `json:"failOpen,omitempty" yaml:"failOpen,omitempty"`
@@ -2834,6 +3013,18 @@ type Lua struct {
 	Code *string
 }
 
+// GRPCJSONTranscoder holds the information associated with the gRPC-JSON transcoder extension
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoder struct {
+	// Name is a unique name for the gRPC-JSON transcoder configuration.
+	// The xds translator only generates one gRPC-JSON transcoder filter for each unique name
+	Name string `json:"name" yaml:"name"`
+	// ProtoDescriptor is the binary proto descriptor set of the gRPC services
+	ProtoDescriptor []byte `json:"protoDescriptor" yaml:"protoDescriptor"`
+	// Services is the list of the fully qualified names of the gRPC services to transcode
+	Services []string `json:"services" yaml:"services"`
+}
+
 // Wasm holds the information associated with the Wasm extensions.
 // +k8s:deepcopy-gen=true
 type Wasm struct {
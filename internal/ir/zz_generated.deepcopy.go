@@ -551,6 +551,16 @@ func (in *ClientTimeout) DeepCopy() *ClientTimeout {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Compression) DeepCopyInto(out *Compression) {
 	*out = *in
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
@@ -606,6 +616,11 @@ func (in *ConsistentHash) DeepCopyInto(out *ConsistentHash) {
 		*out = new(v1alpha1.Cookie)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueryParameter != nil {
+		in, out := &in.QueryParameter, &out.QueryParameter
+		*out = new(QueryParameter)
+		**out = **in
+	}
 	if in.TableSize != nil {
 		in, out := &in.TableSize, &out.TableSize
 		*out = new(uint64)
@@ -679,6 +694,26 @@ func (in *CoreListenerDetails) DeepCopy() *CoreListenerDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialInjection) DeepCopyInto(out *CredentialInjection) {
+	*out = *in
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = make(PrivateBytes, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialInjection.
+func (in *CredentialInjection) DeepCopy() *CredentialInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResponse) DeepCopyInto(out *CustomResponse) {
 	*out = *in
@@ -719,6 +754,11 @@ func (in *CustomResponseMatch) DeepCopyInto(out *CustomResponseMatch) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]v1alpha1.ResponseFailureType, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponseMatch.
@@ -859,6 +899,11 @@ func (in *DestinationSetting) DeepCopyInto(out *DestinationSetting) {
 		*out = new(DestinationFilters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(ProxyProtocol)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationSetting.
@@ -895,6 +940,11 @@ func (in *EnvoyExtensionFeatures) DeepCopyInto(out *EnvoyExtensionFeatures) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionFeatures.
@@ -1018,6 +1068,11 @@ func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.MaxMessageTimeout != nil {
+		in, out := &in.MaxMessageTimeout, &out.MaxMessageTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.FailOpen != nil {
 		in, out := &in.FailOpen, &out.FailOpen
 		*out = new(bool)
@@ -1232,6 +1287,31 @@ func (in *GRPCHealthChecker) DeepCopy() *GRPCHealthChecker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	if in.ProtoDescriptor != nil {
+		in, out := &in.ProtoDescriptor, &out.ProtoDescriptor
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
 	*out = *in
@@ -1316,6 +1396,21 @@ func (in *HTTP2Settings) DeepCopyInto(out *HTTP2Settings) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaxOutboundFrames != nil {
+		in, out := &in.MaxOutboundFrames, &out.MaxOutboundFrames
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxOutboundControlFrames != nil {
+		in, out := &in.MaxOutboundControlFrames, &out.MaxOutboundControlFrames
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConsecutiveInboundFramesWithEmptyPayload != nil {
+		in, out := &in.MaxConsecutiveInboundFramesWithEmptyPayload, &out.MaxConsecutiveInboundFramesWithEmptyPayload
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.ResetStreamOnError != nil {
 		in, out := &in.ResetStreamOnError, &out.ResetStreamOnError
 		*out = new(bool)
@@ -1346,6 +1441,11 @@ func (in *HTTPClientTimeout) DeepCopyInto(out *HTTPClientTimeout) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StreamIdleTimeout != nil {
+		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPClientTimeout.
@@ -1500,6 +1600,11 @@ func (in *HTTPListener) DeepCopyInto(out *HTTPListener) {
 		*out = new(HealthCheckSettings)
 		**out = **in
 	}
+	if in.IPTagging != nil {
+		in, out := &in.IPTagging, &out.IPTagging
+		*out = new(IPTagging)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(ClientTimeout)
@@ -1577,6 +1682,11 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 			}
 		}
 	}
+	if in.BodyMatch != nil {
+		in, out := &in.BodyMatch, &out.BodyMatch
+		*out = new(StringMatch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AddRequestHeaders != nil {
 		in, out := &in.AddRequestHeaders, &out.AddRequestHeaders
 		*out = make([]AddHeader, len(*in))
@@ -1601,6 +1711,11 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CredentialInjection != nil {
+		in, out := &in.CredentialInjection, &out.CredentialInjection
+		*out = new(CredentialInjection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DirectResponse != nil {
 		in, out := &in.DirectResponse, &out.DirectResponse
 		*out = new(CustomResponse)
@@ -1713,6 +1828,11 @@ func (in *HTTPTimeout) DeepCopyInto(out *HTTPTimeout) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.StreamIdleTimeout != nil {
+		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTimeout.
@@ -1725,6 +1845,21 @@ func (in *HTTPTimeout) DeepCopy() *HTTPTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPUpgradeConfig) DeepCopyInto(out *HTTPUpgradeConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPUpgradeConfig.
+func (in *HTTPUpgradeConfig) DeepCopy() *HTTPUpgradeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPUpgradeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPWasmCode) DeepCopyInto(out *HTTPWasmCode) {
 	*out = *in
@@ -1857,6 +1992,58 @@ func (in *HealthCheckSettings) DeepCopy() *HealthCheckSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTag) DeepCopyInto(out *IPTag) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]*CIDRMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CIDRMatch)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTag.
+func (in *IPTag) DeepCopy() *IPTag {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPTagging) DeepCopyInto(out *IPTagging) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*IPTag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPTag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPTagging.
+func (in *IPTagging) DeepCopy() *IPTagging {
+	if in == nil {
+		return nil
+	}
+	out := new(IPTagging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infra) DeepCopyInto(out *Infra) {
 	*out = *in
@@ -2055,6 +2242,11 @@ func (in *LeastRequest) DeepCopyInto(out *LeastRequest) {
 		*out = new(SlowStart)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChoiceCount != nil {
+		in, out := &in.ChoiceCount, &out.ChoiceCount
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeastRequest.
@@ -2781,6 +2973,36 @@ func (in *ResourceMetadata) DeepCopy() *ResourceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCache) DeepCopyInto(out *ResponseCache) {
+	*out = *in
+	if in.AllowedVaryHeaders != nil {
+		in, out := &in.AllowedVaryHeaders, &out.AllowedVaryHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCache.
+func (in *ResponseCache) DeepCopy() *ResponseCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseOverride) DeepCopyInto(out *ResponseOverride) {
 	*out = *in
@@ -2921,6 +3143,26 @@ func (in *RouteDestination) DeepCopy() *RouteDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTracing) DeepCopyInto(out *RouteTracing) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTracing.
+func (in *RouteTracing) DeepCopy() *RouteTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityFeatures) DeepCopyInto(out *SecurityFeatures) {
 	*out = *in
@@ -3004,6 +3246,11 @@ func (in *SlowStart) DeepCopyInto(out *SlowStart) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(float32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlowStart.
@@ -3081,6 +3328,31 @@ func (in *StringMatch) DeepCopy() *StringMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubjectAltName) DeepCopyInto(out *SubjectAltName) {
+	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectAltName.
+func (in *SubjectAltName) DeepCopy() *SubjectAltName {
+	if in == nil {
+		return nil
+	}
+	out := new(SubjectAltName)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPClientTimeout) DeepCopyInto(out *TCPClientTimeout) {
 	*out = *in
@@ -3256,6 +3528,11 @@ func (in *TCPRoute) DeepCopyInto(out *TCPRoute) {
 		*out = new(DNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tunneling != nil {
+		in, out := &in.Tunneling, &out.Tunneling
+		*out = new(TCPTunneling)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRoute.
@@ -3288,6 +3565,21 @@ func (in *TCPTimeout) DeepCopy() *TCPTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPTunneling) DeepCopyInto(out *TCPTunneling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPTunneling.
+func (in *TCPTunneling) DeepCopy() *TCPTunneling {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPTunneling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
@@ -3380,6 +3672,11 @@ func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 		*out = new(TLSCACertificate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Crl != nil {
+		in, out := &in.Crl, &out.Crl
+		*out = new(TLSCrl)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinVersion != nil {
 		in, out := &in.MinVersion, &out.MinVersion
 		*out = new(TLSVersion)
@@ -3410,6 +3707,11 @@ func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SessionTicketKeys != nil {
+		in, out := &in.SessionTicketKeys, &out.SessionTicketKeys
+		*out = new(TLSSessionTicketKeys)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
@@ -3422,6 +3724,26 @@ func (in *TLSConfig) DeepCopy() *TLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSCrl) DeepCopyInto(out *TLSCrl) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSCrl.
+func (in *TLSCrl) DeepCopy() *TLSCrl {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSCrl)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSInspectorConfig) DeepCopyInto(out *TLSInspectorConfig) {
 	*out = *in
@@ -3442,6 +3764,32 @@ func (in *TLSInspectorConfig) DeepCopy() *TLSInspectorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSessionTicketKeys) DeepCopyInto(out *TLSSessionTicketKeys) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]PrivateBytes, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make(PrivateBytes, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSessionTicketKeys.
+func (in *TLSSessionTicketKeys) DeepCopy() *TLSSessionTicketKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSessionTicketKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSUpstreamConfig) DeepCopyInto(out *TLSUpstreamConfig) {
 	*out = *in
@@ -3450,6 +3798,13 @@ func (in *TLSUpstreamConfig) DeepCopyInto(out *TLSUpstreamConfig) {
 		*out = new(TLSCACertificate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]SubjectAltName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.TLSConfig.DeepCopyInto(&out.TLSConfig)
 }
 
@@ -3626,6 +3981,21 @@ func (in *TrafficFeatures) DeepCopyInto(out *TrafficFeatures) {
 			}
 		}
 	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(ResponseCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPUpgrade != nil {
+		in, out := &in.HTTPUpgrade, &out.HTTPUpgrade
+		*out = make([]HTTPUpgradeConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(RouteTracing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficFeatures.
@@ -8,6 +8,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -89,14 +90,18 @@ func newGatewayAPIController(ctx context.Context, mgr manager.Manager, cfg *conf
 	// Gather additional resources to watch from registered extensions
 	var extServerPoliciesGVKs []schema.GroupVersionKind
 	var extGVKs []schema.GroupVersionKind
-	if cfg.EnvoyGateway.ExtensionManager != nil {
-		for _, rsrc := range cfg.EnvoyGateway.ExtensionManager.Resources {
+	for _, extensionManager := range cfg.EnvoyGateway.GetExtensionManagers() {
+		for _, rsrc := range extensionManager.Resources {
 			gvk := schema.GroupVersionKind(rsrc)
-			extGVKs = append(extGVKs, gvk)
+			if !slices.Contains(extGVKs, gvk) {
+				extGVKs = append(extGVKs, gvk)
+			}
 		}
-		for _, rsrc := range cfg.EnvoyGateway.ExtensionManager.PolicyResources {
+		for _, rsrc := range extensionManager.PolicyResources {
 			gvk := schema.GroupVersionKind(rsrc)
-			extServerPoliciesGVKs = append(extServerPoliciesGVKs, gvk)
+			if !slices.Contains(extServerPoliciesGVKs, gvk) {
+				extServerPoliciesGVKs = append(extServerPoliciesGVKs, gvk)
+			}
 		}
 	}
 
@@ -140,11 +145,11 @@ func newGatewayAPIController(ctx context.Context, mgr manager.Manager, cfg *conf
 			case <-ctx.Done():
 				return
 			case <-cfg.Elected:
-				r.subscribeAndUpdateStatus(ctx, cfg.EnvoyGateway.EnvoyGatewaySpec.ExtensionManager != nil)
+				r.subscribeAndUpdateStatus(ctx, len(cfg.EnvoyGateway.GetExtensionManagers()) > 0)
 			}
 		}()
 	} else {
-		r.subscribeAndUpdateStatus(ctx, cfg.EnvoyGateway.EnvoyGatewaySpec.ExtensionManager != nil)
+		r.subscribeAndUpdateStatus(ctx, len(cfg.EnvoyGateway.GetExtensionManagers()) > 0)
 	}
 	return nil
 }
@@ -702,7 +707,7 @@ func (r *gatewayAPIReconciler) processSecretRef(
 	return nil
 }
 
-// processCtpConfigMapRefs adds the referenced ConfigMaps in ClientTrafficPolicies
+// processCtpConfigMapRefs adds the referenced ConfigMaps and Secrets in ClientTrafficPolicies
 // to the resourceTree
 func (r *gatewayAPIReconciler) processCtpConfigMapRefs(
 	ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings,
@@ -747,6 +752,59 @@ func (r *gatewayAPIReconciler) processCtpConfigMapRefs(
 				}
 			}
 		}
+
+		if tls != nil && tls.ClientValidation != nil && tls.ClientValidation.Crl != nil {
+			for _, crlRef := range tls.ClientValidation.Crl.Refs {
+				var err error
+				if crlRef.Kind != nil && string(*crlRef.Kind) == resource.KindConfigMap {
+					err = r.processConfigMapRef(
+						ctx,
+						resourceMap,
+						resourceTree,
+						resource.KindClientTrafficPolicy,
+						policy.Namespace,
+						policy.Name,
+						crlRef)
+				} else if crlRef.Kind == nil || string(*crlRef.Kind) == resource.KindSecret {
+					err = r.processSecretRef(
+						ctx,
+						resourceMap,
+						resourceTree,
+						resource.KindClientTrafficPolicy,
+						policy.Namespace,
+						policy.Name,
+						crlRef)
+				}
+				if err != nil {
+					// This ClientTrafficPolicy will be marked as invalid in its status
+					// when translating to IR because the referenced resource can't be
+					// found.
+					r.log.Error(err,
+						"failed to process Crl Ref for ClientTrafficPolicy",
+						"policy", policy, "crlRef", crlRef.Name)
+				}
+			}
+		}
+
+		if tls != nil && tls.Session != nil && tls.Session.Resumption != nil && tls.Session.Resumption.Stateless != nil {
+			for _, keysRef := range tls.Session.Resumption.Stateless.SessionTicketKeysRefs {
+				if err := r.processSecretRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindClientTrafficPolicy,
+					policy.Namespace,
+					policy.Name,
+					keysRef); err != nil {
+					// This ClientTrafficPolicy will be marked as invalid in its status
+					// when translating to IR because the referenced secret can't be
+					// found.
+					r.log.Error(err,
+						"failed to process SessionTicketKeysRef for ClientTrafficPolicy",
+						"policy", policy, "sessionTicketKeysRef", keysRef.Name)
+				}
+			}
+		}
 	}
 }
 
@@ -1935,6 +1993,9 @@ func (r *gatewayAPIReconciler) processEnvoyProxy(ep *egv1a1.EnvoyProxy, resource
 				if sink.OpenTelemetry != nil {
 					backendRefs = append(backendRefs, sink.OpenTelemetry.BackendRefs...)
 				}
+				if sink.StatsD != nil {
+					backendRefs = append(backendRefs, sink.StatsD.BackendRefs...)
+				}
 			}
 		}
 
@@ -2103,6 +2164,7 @@ func (r *gatewayAPIReconciler) processExtensionServerPolicies(
 // - BackendRefs for ExtProcs
 // - SecretRefs for Wasms
 // - ValueRefs for Luas
+// - ValueRef for the proto descriptor set of the gRPC-JSON transcoder
 func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 	ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings,
 ) {
@@ -2173,6 +2235,20 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 						"policy", policy, "secretRef", wasm.Code.Image.PullSecretRef)
 				}
 			}
+			if wasm.Code.Image != nil && wasm.Code.Image.SignatureVerification != nil {
+				if err := r.processSecretRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindEnvoyExtensionPolicy,
+					policy.Namespace,
+					policy.Name,
+					wasm.Code.Image.SignatureVerification.PublicKeyRef); err != nil {
+					r.log.Error(err,
+						"failed to process Wasm Image SignatureVerification PublicKeyRef for EnvoyExtensionPolicy",
+						"policy", policy, "secretRef", wasm.Code.Image.SignatureVerification.PublicKeyRef)
+				}
+			}
 		}
 
 		// Add referenced ConfigMaps in Lua EnvoyExtensionPolicies to the resource tree
@@ -2199,5 +2275,30 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 				}
 			}
 		}
+
+		// Add the referenced ConfigMap of the proto descriptor set of the gRPC-JSON transcoder to the resource tree
+		if transcoder := policy.Spec.GRPCJSONTranscoder; transcoder != nil &&
+			transcoder.ProtoDescriptor.Type == egv1a1.ProtoDescriptorValueTypeValueRef {
+			valueRef := transcoder.ProtoDescriptor.ValueRef
+			if valueRef != nil && string(valueRef.Kind) == resource.KindConfigMap {
+				configMap := new(corev1.ConfigMap)
+				err := r.client.Get(ctx,
+					types.NamespacedName{Namespace: policy.Namespace, Name: string(valueRef.Name)},
+					configMap,
+				)
+				if err != nil {
+					r.log.Error(err,
+						"failed to process GRPCJSONTranscoder ValueRef for EnvoyExtensionPolicy",
+						"policy", policy, "ValueRef", valueRef.Name)
+				}
+
+				resourceMap.allAssociatedNamespaces.Insert(policy.Namespace)
+				if !resourceMap.allAssociatedConfigMaps.Has(utils.NamespacedName(configMap).String()) {
+					resourceMap.allAssociatedConfigMaps.Insert(utils.NamespacedName(configMap).String())
+					resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+					r.log.Info("processing ConfigMap", "namespace", policy.Namespace, "name", string(valueRef.Name))
+				}
+			}
+		}
 	}
 }
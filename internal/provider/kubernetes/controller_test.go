@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +24,7 @@ import (
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/utils"
 )
 
 func TestAddGatewayClassFinalizer(t *testing.T) {
@@ -449,3 +451,114 @@ func TestProcessEnvoyExtensionPolicyObjectRefs(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessCtpConfigMapRefs(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ctp              *egv1a1.ClientTrafficPolicy
+		objects          []client.Object
+		expectSecrets    []string
+		expectConfigMaps []string
+	}{
+		{
+			name: "session ticket keys secrets",
+			ctp: &egv1a1.ClientTrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test-policy",
+				},
+				Spec: egv1a1.ClientTrafficPolicySpec{
+					TLS: &egv1a1.ClientTLSSettings{
+						Session: &egv1a1.Session{
+							Resumption: &egv1a1.SessionResumption{
+								Stateless: &egv1a1.StatelessTLSSessionResumption{
+									SessionTicketKeysRefs: []gwapiv1.SecretObjectReference{
+										{Name: "ticket-key-1"},
+										{Name: "ticket-key-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ticket-key-1"}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ticket-key-2"}},
+			},
+			expectSecrets: []string{"default/ticket-key-1", "default/ticket-key-2"},
+		},
+		{
+			name: "certificate revocation lists secret and configmap",
+			ctp: &egv1a1.ClientTrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test-policy",
+				},
+				Spec: egv1a1.ClientTrafficPolicySpec{
+					TLS: &egv1a1.ClientTLSSettings{
+						ClientValidation: &egv1a1.ClientValidationContext{
+							Crl: &egv1a1.CrlContext{
+								Refs: []gwapiv1.SecretObjectReference{
+									{Name: "crl-secret"},
+									{
+										Kind: gatewayapi.KindPtr(resource.KindConfigMap),
+										Name: "crl-configmap",
+									},
+									{
+										Kind:      gatewayapi.KindPtr(resource.KindConfigMap),
+										Namespace: gatewayapi.NamespacePtr("ns-2"),
+										Name:      "crl-configmap",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "crl-secret"}},
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "crl-configmap"}},
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "crl-configmap"}},
+			},
+			expectSecrets:    []string{"default/crl-secret"},
+			expectConfigMaps: []string{"default/crl-configmap"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			logger := logging.DefaultLogger(egv1a1.LogLevelInfo)
+
+			r := &gatewayAPIReconciler{
+				log:             logger,
+				classController: "some-gateway-class",
+			}
+
+			r.client = fakeclient.NewClientBuilder().
+				WithScheme(envoygateway.GetScheme()).
+				WithObjects(append(tc.objects, tc.ctp)...).
+				WithIndex(&gwapiv1b1.ReferenceGrant{}, targetRefGrantRouteIndex, getReferenceGrantIndexerFunc()).
+				Build()
+
+			resourceTree := resource.NewResources()
+			resourceTree.ClientTrafficPolicies = append(resourceTree.ClientTrafficPolicies, tc.ctp)
+			resourceMap := newResourceMapping()
+
+			r.processCtpConfigMapRefs(context.Background(), resourceTree, resourceMap)
+
+			secrets := make([]string, 0, len(resourceTree.Secrets))
+			for _, secret := range resourceTree.Secrets {
+				secrets = append(secrets, utils.NamespacedName(secret).String())
+			}
+			require.ElementsMatch(t, tc.expectSecrets, secrets)
+
+			configMaps := make([]string, 0, len(resourceTree.ConfigMaps))
+			for _, configMap := range resourceTree.ConfigMaps {
+				configMaps = append(configMaps, utils.NamespacedName(configMap).String())
+			}
+			require.ElementsMatch(t, tc.expectConfigMaps, configMaps)
+		})
+	}
+}
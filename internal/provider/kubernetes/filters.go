@@ -92,3 +92,33 @@ func (r *gatewayAPIReconciler) processRouteFilterConfigMapRef(
 		}
 	}
 }
+
+// processRouteFilterSecretRef adds the Secret referenced by the credentialInjection
+// of a HTTPRouteFilter to the resourceTree
+func (r *gatewayAPIReconciler) processRouteFilterSecretRef(
+	ctx context.Context, filter *egv1a1.HTTPRouteFilter,
+	resourceMap *resourceMappings, resourceTree *resource.Resources,
+) {
+	if filter.Spec.CredentialInjection == nil {
+		return
+	}
+
+	// we don't return an error here, because we want to continue
+	// reconciling the rest of the HTTPRouteFilter despite that this
+	// reference is invalid.
+	// This HTTPRouteFilter will be marked as invalid in its status
+	// when translating to IR because the referenced secret can't be
+	// found.
+	if err := r.processSecretRef(
+		ctx,
+		resourceMap,
+		resourceTree,
+		resource.KindHTTPRouteFilter,
+		filter.Namespace,
+		filter.Name,
+		filter.Spec.CredentialInjection.SecretRef); err != nil {
+		r.log.Error(err,
+			"failed to process CredentialInjection SecretRef for HTTPRouteFilter",
+			"filter", filter, "SecretRef", filter.Spec.CredentialInjection.SecretRef.Name)
+	}
+}
@@ -49,6 +49,7 @@ const (
 	httpRouteFilterHTTPRouteIndex    = "httpRouteFilterHTTPRouteIndex"
 	configMapBtpIndex                = "configMapBtpIndex"
 	configMapHTTPRouteFilterIndex    = "configMapHTTPRouteFilterIndex"
+	secretHTTPRouteFilterIndex       = "secretHTTPRouteFilterIndex"
 )
 
 func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
@@ -140,6 +141,18 @@ func httpRouteFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
 				)
 			}
 		}
+		for _, backendRef := range rule.BackendRefs {
+			for _, filter := range backendRef.Filters {
+				if filter.ExtensionRef != nil && string(filter.ExtensionRef.Kind) == resource.KindHTTPRouteFilter {
+					httpRouteFilterRefs = append(httpRouteFilterRefs,
+						types.NamespacedName{
+							Namespace: httproute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
 	}
 	return httpRouteFilterRefs
 }
@@ -226,16 +239,23 @@ func metricRefs(ep *egv1a1.EnvoyProxy) []string {
 	}
 
 	for _, sink := range ep.Spec.Telemetry.Metrics.Sinks {
+		var backendRefs []egv1a1.BackendRef
 		if sink.OpenTelemetry != nil {
-			for _, backend := range sink.OpenTelemetry.BackendRefs {
-				if backend.Kind == nil || string(*backend.Kind) == resource.KindService {
-					refs = append(refs,
-						types.NamespacedName{
-							Namespace: gatewayapi.NamespaceDerefOr(backend.Namespace, ep.Namespace),
-							Name:      string(backend.Name),
-						}.String(),
-					)
-				}
+			backendRefs = append(backendRefs, sink.OpenTelemetry.BackendRefs...)
+		}
+
+		if sink.StatsD != nil {
+			backendRefs = append(backendRefs, sink.StatsD.BackendRefs...)
+		}
+
+		for _, backend := range backendRefs {
+			if backend.Kind == nil || string(*backend.Kind) == resource.KindService {
+				refs = append(refs,
+					types.NamespacedName{
+						Namespace: gatewayapi.NamespaceDerefOr(backend.Namespace, ep.Namespace),
+						Name:      string(backend.Name),
+					}.String(),
+				)
 			}
 		}
 	}
@@ -627,6 +647,18 @@ func configMapCtpIndexFunc(rawObj client.Object) []string {
 				)
 			}
 		}
+		if crl := ctp.Spec.TLS.ClientValidation.Crl; crl != nil {
+			for _, crlRef := range crl.Refs {
+				if crlRef.Kind != nil && string(*crlRef.Kind) == resource.KindConfigMap {
+					configMapReferences = append(configMapReferences,
+						types.NamespacedName{
+							Namespace: gatewayapi.NamespaceDerefOr(crlRef.Namespace, ctp.Namespace),
+							Name:      string(crlRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
 	}
 	return configMapReferences
 }
@@ -647,6 +679,28 @@ func secretCtpIndexFunc(rawObj client.Object) []string {
 				)
 			}
 		}
+		if crl := ctp.Spec.TLS.ClientValidation.Crl; crl != nil {
+			for _, crlRef := range crl.Refs {
+				if crlRef.Kind == nil || string(*crlRef.Kind) == resource.KindSecret {
+					secretReferences = append(secretReferences,
+						types.NamespacedName{
+							Namespace: gatewayapi.NamespaceDerefOr(crlRef.Namespace, ctp.Namespace),
+							Name:      string(crlRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	if tls := ctp.Spec.TLS; tls != nil && tls.Session != nil && tls.Session.Resumption != nil && tls.Session.Resumption.Stateless != nil {
+		for _, keysRef := range tls.Session.Resumption.Stateless.SessionTicketKeysRefs {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(keysRef.Namespace, ctp.Namespace),
+					Name:      string(keysRef.Name),
+				}.String(),
+			)
+		}
 	}
 	return secretReferences
 }
@@ -681,17 +735,38 @@ func configMapBtpIndexFunc(rawObj client.Object) []string {
 	return configMapReferences
 }
 
-// addRouteFilterIndexers adds indexing on HTTPRouteFilter, for ConfigMap objects that are
+// addRouteFilterIndexers adds indexing on HTTPRouteFilter, for ConfigMap and Secret objects that are
 // referenced in HTTPRouteFilter objects. This helps in querying for HTTPRouteFilters that are
-// affected by a particular ConfigMap CRUD.
+// affected by a particular ConfigMap or Secret CRUD.
 func addRouteFilterIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.HTTPRouteFilter{},
 		configMapHTTPRouteFilterIndex, configMapRouteFilterIndexFunc); err != nil {
 		return err
 	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.HTTPRouteFilter{},
+		secretHTTPRouteFilterIndex, secretRouteFilterIndexFunc); err != nil {
+		return err
+	}
 	return nil
 }
 
+func secretRouteFilterIndexFunc(rawObj client.Object) []string {
+	filter := rawObj.(*egv1a1.HTTPRouteFilter)
+	var secretReferences []string
+	if filter.Spec.CredentialInjection != nil {
+		secretRef := filter.Spec.CredentialInjection.SecretRef
+		if secretRef.Kind == nil || string(*secretRef.Kind) == resource.KindSecret {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(secretRef.Namespace, filter.Namespace),
+					Name:      string(secretRef.Name),
+				}.String(),
+			)
+		}
+	}
+	return secretReferences
+}
+
 func configMapRouteFilterIndexFunc(rawObj client.Object) []string {
 	filter := rawObj.(*egv1a1.HTTPRouteFilter)
 	var configMapReferences []string
@@ -815,6 +890,14 @@ func secretEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
 					Name:      string(secretRef.Name),
 				}.String())
 		}
+		if wasm.Code.Image != nil && wasm.Code.Image.SignatureVerification != nil {
+			secretRef := wasm.Code.Image.SignatureVerification.PublicKeyRef
+			ret = append(ret,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(secretRef.Namespace, envoyExtensionPolicy.Namespace),
+					Name:      string(secretRef.Name),
+				}.String())
+		}
 	}
 
 	return ret
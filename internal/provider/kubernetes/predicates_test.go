@@ -380,6 +380,78 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
 			expect: true,
 		},
+		{
+			name: "references ClientTrafficPolicy session ticket keys",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&egv1a1.ClientTrafficPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "session-ticket-keys",
+					},
+					Spec: egv1a1.ClientTrafficPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						TLS: &egv1a1.ClientTLSSettings{
+							Session: &egv1a1.Session{
+								Resumption: &egv1a1.SessionResumption{
+									Stateless: &egv1a1.StatelessTLSSessionResumption{
+										SessionTicketKeysRefs: []gwapiv1.SecretObjectReference{
+											{
+												Name: "secret",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
+		{
+			name: "references ClientTrafficPolicy certificate revocation list",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&egv1a1.ClientTrafficPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "crl",
+					},
+					Spec: egv1a1.ClientTrafficPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						TLS: &egv1a1.ClientTLSSettings{
+							ClientValidation: &egv1a1.ClientValidationContext{
+								Crl: &egv1a1.CrlContext{
+									Refs: []gwapiv1.SecretObjectReference{
+										{
+											Name: "secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
 	}
 
 	// Create the reconciler.
@@ -391,6 +463,7 @@ func TestValidateSecretForReconcile(t *testing.T) {
 		spCRDExists:     true,
 		epCRDExists:     true,
 		eepCRDExists:    true,
+		ctpCRDExists:    true,
 	}
 
 	for _, tc := range testCases {
@@ -401,6 +474,7 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			WithIndex(&egv1a1.SecurityPolicy{}, secretSecurityPolicyIndex, secretSecurityPolicyIndexFunc).
 			WithIndex(&egv1a1.EnvoyProxy{}, secretEnvoyProxyIndex, secretEnvoyProxyIndexFunc).
 			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, secretEnvoyExtensionPolicyIndex, secretEnvoyExtensionPolicyIndexFunc).
+			WithIndex(&egv1a1.ClientTrafficPolicy{}, secretCtpIndex, secretCtpIndexFunc).
 			Build()
 		t.Run(tc.name, func(t *testing.T) {
 			res := r.validateSecretForReconcile(tc.secret)
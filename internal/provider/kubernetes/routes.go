@@ -281,6 +281,34 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 					continue
 				}
 
+				// Load in the HTTPRouteFilters injecting the credentials of the backendRef
+				for _, filter := range backendRef.Filters {
+					if filter.Type != gwapiv1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil ||
+						string(filter.ExtensionRef.Kind) != egv1a1.KindHTTPRouteFilter || !r.hrfCRDExists {
+						continue
+					}
+					key := utils.NamespacedNameWithGroupKind{
+						NamespacedName: types.NamespacedName{
+							Namespace: httpRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						},
+						GroupKind: schema.GroupKind{
+							Group: string(filter.ExtensionRef.Group),
+							Kind:  string(filter.ExtensionRef.Kind),
+						},
+					}
+					httpFilter, err := r.getHTTPRouteFilter(ctx, key.Name, key.Namespace)
+					if err != nil {
+						r.log.Error(err, "HTTPRouteFilters not found; bypassing backendRef filter")
+						continue
+					}
+					if !resourceMap.allAssociatedHTTPRouteExtensionFilters.Has(key) {
+						r.processRouteFilterSecretRef(ctx, httpFilter, resourceMap, resourceTree)
+						resourceMap.allAssociatedHTTPRouteExtensionFilters.Insert(key)
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpFilter)
+					}
+				}
+
 				backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, httpRoute.Namespace)
 				resourceMap.allAssociatedBackendRefs.Insert(gwapiv1.BackendObjectReference{
 					Group:     backendRef.BackendObjectReference.Group,
@@ -412,6 +440,7 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 							}
 							if !resourceMap.allAssociatedHTTPRouteExtensionFilters.Has(key) {
 								r.processRouteFilterConfigMapRef(ctx, httpFilter, resourceMap, resourceTree)
+								r.processRouteFilterSecretRef(ctx, httpFilter, resourceMap, resourceTree)
 								resourceMap.allAssociatedHTTPRouteExtensionFilters.Insert(key)
 								resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpFilter)
 							}
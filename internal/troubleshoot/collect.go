@@ -67,6 +67,12 @@ func CollectResult(ctx context.Context, restConfig *rest.Config, bundlePath stri
 			ClientConfig: restConfig,
 			Namespace:    egNamespace,
 		},
+		// Collect versions of the client, Kubernetes and EnvoyGateway
+		collect.Version{
+			BundlePath:   bundlePath,
+			ClientConfig: restConfig,
+			Namespace:    egNamespace,
+		},
 	}
 	total := len(collectors)
 	allCollectedData := make(map[string][]byte)
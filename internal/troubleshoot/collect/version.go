@@ -0,0 +1,113 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package collect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	troubleshootv1b2 "github.com/replicatedhq/troubleshoot/pkg/apis/troubleshoot/v1beta2"
+	tbcollect "github.com/replicatedhq/troubleshoot/pkg/collect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+
+	"github.com/envoyproxy/gateway/internal/cmd/version"
+	kube "github.com/envoyproxy/gateway/internal/kubernetes"
+)
+
+var _ tbcollect.Collector = &Version{}
+
+// Version defines a collector for the versions of the client, the Kubernetes API server
+// and the Envoy Gateway controllers in the given namespace.
+type Version struct {
+	BundlePath   string
+	Namespace    string
+	ClientConfig *rest.Config
+}
+
+// versionInfo is the content of the collected version.json.
+type versionInfo struct {
+	Client     version.Info        `json:"client"`
+	Kubernetes *k8sversion.Info    `json:"kubernetes,omitempty"`
+	Server     []serverVersionInfo `json:"server,omitempty"`
+	Errors     []string            `json:"errors,omitempty"`
+}
+
+type serverVersionInfo struct {
+	types.NamespacedName
+	version.Info
+}
+
+func (v Version) Title() string {
+	return "version"
+}
+
+func (v Version) IsExcluded() (bool, error) {
+	return false, nil
+}
+
+func (v Version) GetRBACErrors() []error {
+	return nil
+}
+
+func (v Version) HasRBACErrors() bool {
+	return false
+}
+
+func (v Version) CheckRBAC(_ context.Context, _ tbcollect.Collector, _ *troubleshootv1b2.Collect, _ *rest.Config, _ string) error {
+	return nil
+}
+
+func (v Version) Collect(_ chan<- interface{}) (tbcollect.CollectorResult, error) {
+	output := tbcollect.NewResult()
+
+	cliClient, err := kube.NewForRestConfig(v.ClientConfig)
+	if err != nil {
+		return output, err
+	}
+
+	info := versionInfo{Client: version.Get()}
+	if info.Kubernetes, err = cliClient.Kube().Discovery().ServerVersion(); err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("failed to get kubernetes version: %v", err))
+	}
+
+	pods, err := cliClient.PodsForSelector(v.Namespace, "control-plane=envoy-gateway")
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("failed to list envoy gateway pods: %v", err))
+	} else {
+		for _, pod := range pods.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+
+			nn := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+			stdout, _, err := cliClient.PodExec(nn, "envoy-gateway", "envoy-gateway version -ojson")
+			if err != nil {
+				info.Errors = append(info.Errors, fmt.Sprintf("failed to get version for pod %s/%s: %v", pod.Namespace, pod.Name, err))
+				continue
+			}
+
+			server := serverVersionInfo{NamespacedName: nn}
+			if err := json.Unmarshal([]byte(stdout), &server.Info); err != nil {
+				info.Errors = append(info.Errors, fmt.Sprintf("failed to parse version for pod %s/%s: %v", pod.Namespace, pod.Name, err))
+				continue
+			}
+			info.Server = append(info.Server, server)
+		}
+	}
+
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return output, err
+	}
+	_ = output.SaveResult(v.BundlePath, "version.json", bytes.NewBuffer(data))
+
+	return output, nil
+}
@@ -125,6 +125,9 @@ type cacheEntry struct {
 	checksum string
 	// size is the size of the module.
 	size int
+	// digest is the sha256 digest of the OCI image the module is extracted from.
+	// It is empty if the module is not from an OCI image.
+	digest string
 }
 
 // newLocalFileCache create a new Wasm module cache which downloads and stores Wasm module files locally.
@@ -217,13 +220,27 @@ func (c *localFileCache) getOrFetch(key cacheKey, opts GetOptions) (*cacheEntry,
 
 	// First check if the cache entry is already downloaded and policy does not require pulling always.
 	ce := c.getEntry(key, opts.PullPolicy, u)
-	if ce != nil {
+	if ce != nil && u.Scheme == "oci" {
+		switch {
+		// The cached image may have been fetched without verifying its signature.
+		// The signature is verified against the image the URL currently points to, so the
+		// cached module is only used if it was extracted from that very image.
+		case len(opts.PublicKey) > 0:
+			var verifiedDigest string
+			if _, verifiedDigest, err = c.prepareFetch(ctx, u, insecure, opts); err != nil {
+				return nil, fmt.Errorf("could not verify Wasm OCI image: %w", err)
+			}
+			if verifiedDigest != ce.digest {
+				ce = nil
+			}
 		// We still need to check if the pull secret is correct if it is a private OCI image.
-		if u.Scheme == "oci" && ce.isPrivate {
+		case ce.isPrivate:
 			if err = c.checkPermission(ctx, u, insecure, opts); err != nil {
 				return nil, err
 			}
 		}
+	}
+	if ce != nil {
 		return ce, nil
 	}
 
@@ -301,7 +318,8 @@ func (c *localFileCache) prepareFetch(
 	binaryFetcher func() ([]byte, error), actualDigest string, err error,
 ) {
 	imgFetcherOps := ImageFetcherOption{
-		Insecure: insecure,
+		Insecure:  insecure,
+		PublicKey: opts.PublicKey,
 	}
 	if len(opts.PullSecret) > 0 {
 		imgFetcherOps.PullSecret = opts.PullSecret
@@ -364,6 +382,9 @@ func (c *localFileCache) addEntry(key cacheKey, wasmModule []byte, isPrivate boo
 		checksum:        wasmChecksum,
 		size:            len(wasmModule),
 	}
+	if strings.HasPrefix(key.downloadURL, ociURLPrefix) {
+		ce.digest = key.checksum
+	}
 	ce.referencingURLs.Insert(key.downloadURL)
 	c.modules[key.moduleKey] = &ce
 
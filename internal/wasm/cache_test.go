@@ -268,7 +268,7 @@ func TestWasmCache(t *testing.T) {
 				RequestTimeout:  time.Second * 10,
 			},
 			wantCachedModules: map[moduleKey]*cacheEntry{
-				{name: moduleNameFromURL(ociURLWithTag), checksum: dockerImageDigest}: {modulePath: ociWasmFile, checksum: wasmDataCheckSum, size: 27},
+				{name: moduleNameFromURL(ociURLWithTag), checksum: dockerImageDigest}: {modulePath: ociWasmFile, checksum: wasmDataCheckSum, size: 27, digest: dockerImageDigest},
 			},
 			wantCachedChecksums: map[string]*checksumEntry{
 				ociURLWithTag: {checksum: dockerImageDigest, resourceVersionByResource: map[string]string{"namespace.resource": "0"}},
@@ -288,7 +288,7 @@ func TestWasmCache(t *testing.T) {
 				RequestTimeout:  time.Second * 10,
 			},
 			wantCachedModules: map[moduleKey]*cacheEntry{
-				{name: moduleNameFromURL(ociURLWithTag), checksum: dockerImageDigest}: {modulePath: ociWasmFile, checksum: wasmDataCheckSum, size: 27},
+				{name: moduleNameFromURL(ociURLWithTag), checksum: dockerImageDigest}: {modulePath: ociWasmFile, checksum: wasmDataCheckSum, size: 27, digest: dockerImageDigest},
 			},
 			wantCachedChecksums: map[string]*checksumEntry{
 				ociURLWithTag: {checksum: dockerImageDigest, resourceVersionByResource: map[string]string{"namespace.resource": "0"}},
@@ -51,6 +51,9 @@ import (
 type ImageFetcherOption struct {
 	PullSecret []byte
 	Insecure   bool
+	// PublicKey is the PEM encoded public key used to verify the cosign signature of the image.
+	// The signature isn't verified if it's empty.
+	PublicKey []byte
 }
 
 func (o *ImageFetcherOption) useAnonymous() bool {
@@ -66,6 +69,7 @@ func (o *ImageFetcherOption) String() string {
 
 type ImageFetcher struct {
 	fetchOpts []remote.Option
+	publicKey []byte
 	logger    logging.Logger
 }
 
@@ -90,6 +94,7 @@ func NewImageFetcher(ctx context.Context, opt ImageFetcherOption, logger logging
 
 	return &ImageFetcher{
 		fetchOpts: append(fetchOpts, remote.WithContext(ctx)),
+		publicKey: opt.PublicKey,
 		logger:    logger,
 	}
 }
@@ -122,6 +127,14 @@ func (o *ImageFetcher) PrepareFetch(url string) (binaryFetcher func() ([]byte, e
 		return
 	}
 
+	// Verify the signature of the image before using it.
+	if len(o.publicKey) > 0 {
+		if err = o.verifyImageSignature(ref, desc.Digest); err != nil {
+			err = fmt.Errorf("could not verify image signature: %w", err)
+			return
+		}
+	}
+
 	// Fetch image.
 	img, err := desc.Image()
 	if err != nil {
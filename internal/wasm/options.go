@@ -105,4 +105,6 @@ type GetOptions struct {
 	RequestTimeout  time.Duration
 	PullSecret      []byte
 	PullPolicy      PullPolicy
+	// PublicKey is the PEM encoded public key used to verify the cosign signature of an OCI image.
+	PublicKey []byte
 }
@@ -0,0 +1,154 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// This file implements the verification of the signatures created by `cosign sign --key`.
+// Cosign stores the signatures of an image in the image tagged `<algorithm>-<digest>.sig` in the same repository.
+// Each layer of the signature image is a simple signing payload referencing the digest of the signed image,
+// with the signature of the payload in the annotation of the layer.
+// https://github.com/sigstore/cosign/blob/main/specs/SIGNATURE_SPEC.md
+
+const (
+	cosignSignatureTagSuffix  = "sig"
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// maxSignaturePayloadSize is the limit of the size of a simple signing payload.
+	maxSignaturePayloadSize = 1 << 20
+)
+
+// simpleSigningPayload is the part of the cosign simple signing payload that is verified.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// parsePublicKey parses a PEM encoded public key, as generated by `cosign generate-key-pair`.
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key: %w", err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// verifyImageSignature checks that the image with the given digest has at least one cosign signature
+// that is valid for the public key.
+func (o *ImageFetcher) verifyImageSignature(ref name.Reference, digest v1.Hash) error {
+	publicKey, err := parsePublicKey(o.publicKey)
+	if err != nil {
+		return err
+	}
+
+	sigRef := ref.Context().Tag(fmt.Sprintf("%s-%s.%s", digest.Algorithm, digest.Hex, cosignSignatureTagSuffix))
+	sigImg, err := remote.Image(sigRef, o.fetchOpts...)
+	if err != nil {
+		return fmt.Errorf("could not fetch signatures %s: %w", sigRef, err)
+	}
+	manifest, err := sigImg.Manifest()
+	if err != nil {
+		return fmt.Errorf("could not retrieve manifest of signatures: %w", err)
+	}
+
+	var errs error
+	for _, desc := range manifest.Layers {
+		if err := verifySignatureLayer(sigImg, desc, publicKey, digest); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		return nil
+	}
+	if errs == nil {
+		errs = errors.New("no signature found")
+	}
+	return fmt.Errorf("no valid signature found for %s: %w", digest, errs)
+}
+
+func verifySignatureLayer(sigImg v1.Image, desc v1.Descriptor, publicKey crypto.PublicKey, digest v1.Hash) error {
+	encoded, ok := desc.Annotations[cosignSignatureAnnotation]
+	if !ok {
+		return fmt.Errorf("layer %s has no signature annotation", desc.Digest)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("could not decode signature of layer %s: %w", desc.Digest, err)
+	}
+
+	layer, err := sigImg.LayerByDigest(desc.Digest)
+	if err != nil {
+		return fmt.Errorf("could not fetch layer %s: %w", desc.Digest, err)
+	}
+	// The simple signing payload is stored as is, it's not compressed.
+	r, err := layer.Compressed()
+	if err != nil {
+		return fmt.Errorf("could not get content of layer %s: %w", desc.Digest, err)
+	}
+	defer r.Close()
+	payload, err := io.ReadAll(io.LimitReader(r, maxSignaturePayloadSize))
+	if err != nil {
+		return fmt.Errorf("could not read content of layer %s: %w", desc.Digest, err)
+	}
+
+	return verifySignaturePayload(publicKey, payload, signature, digest)
+}
+
+// verifySignaturePayload checks that the signature of the payload is valid for the public key,
+// and that the payload references the digest of the image.
+func verifySignaturePayload(publicKey crypto.PublicKey, payload, signature []byte, digest v1.Hash) error {
+	hash := sha256.Sum256(payload)
+	var valid bool
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, payload, signature)
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	if !valid {
+		return errors.New("invalid signature")
+	}
+
+	p := &simpleSigningPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return fmt.Errorf("could not parse signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest.String() {
+		return fmt.Errorf("signature is for digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
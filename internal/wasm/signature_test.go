@@ -0,0 +1,260 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+func newTestKeyPair(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// pushSignature pushes a cosign signature image for the image with the given digest,
+// with a payload referencing signedDigest signed with the key.
+func pushSignature(t *testing.T, repo string, key *ecdsa.PrivateKey, digest, signedDigest v1.Hash) {
+	t.Helper()
+	payload := []byte(fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`,
+		repo, signedDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer := static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json")
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: layer,
+		Annotations: map[string]string{
+			cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := fmt.Sprintf("%s:%s-%s.sig", repo, digest.Algorithm, digest.Hex)
+	if err := crane.Push(img, ref); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImageFetcher_VerifySignature(t *testing.T) {
+	// Set up a fake registry.
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, publicKey := newTestKeyPair(t)
+	otherKey, otherPublicKey := newTestKeyPair(t)
+
+	pushImage := func(repo string) v1.Hash {
+		l, err := newMockLayer(types.DockerLayer,
+			map[string][]byte{"plugin.wasm": []byte("this is wasm plugin " + repo)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: l})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := crane.Push(img, repo); err != nil {
+			t.Fatal(err)
+		}
+		d, err := img.Digest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	signed := fmt.Sprintf("%s/test/signed", u.Host)
+	signedDigest := pushImage(signed)
+	pushSignature(t, signed, key, signedDigest, signedDigest)
+
+	unsigned := fmt.Sprintf("%s/test/unsigned", u.Host)
+	pushImage(unsigned)
+
+	wrongDigest := fmt.Sprintf("%s/test/wrong-digest", u.Host)
+	digest := pushImage(wrongDigest)
+	// The signature references the digest of another image.
+	pushSignature(t, wrongDigest, otherKey, digest, signedDigest)
+
+	cases := []struct {
+		name      string
+		ref       string
+		publicKey []byte
+		wantErr   string
+	}{
+		{
+			name:      "valid signature",
+			ref:       signed,
+			publicKey: publicKey,
+		},
+		{
+			name:      "signed with another key",
+			ref:       signed,
+			publicKey: otherPublicKey,
+			wantErr:   "invalid signature",
+		},
+		{
+			name:      "missing signature",
+			ref:       unsigned,
+			publicKey: publicKey,
+			wantErr:   "could not fetch signatures",
+		},
+		{
+			name:      "signature for another digest",
+			ref:       wrongDigest,
+			publicKey: otherPublicKey,
+			wantErr:   "signature is for digest " + signedDigest.String(),
+		},
+		{
+			name:      "invalid public key",
+			ref:       signed,
+			publicKey: []byte("invalid"),
+			wantErr:   "no PEM encoded public key found",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fetcher := ImageFetcher{
+				fetchOpts: []remote.Option{remote.WithAuth(authn.Anonymous)},
+				publicKey: c.publicKey,
+				logger:    logging.DefaultLogger(egv1a1.LogLevelInfo),
+			}
+			binaryFetcher, _, err := fetcher.PrepareFetch(c.ref)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := binaryFetcher(); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("PrepareFetch got error %v, want error containing %q", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestWasmCache_VerifySignatureOnCacheHit(t *testing.T) {
+	// Set up a fake registry.
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, publicKey := newTestKeyPair(t)
+	repo := fmt.Sprintf("%s/test/plugin", u.Host)
+	downloadURL := fmt.Sprintf("oci://%s:v0.1.0", repo)
+
+	// pushImage pushes a Wasm image with the given content to the same tag.
+	pushImage := func(content string) v1.Hash {
+		l, err := newMockLayer(types.DockerLayer,
+			map[string][]byte{"plugin.wasm": append(append([]byte{}, wasmHeader...), content...)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := mutate.Append(empty.Image, mutate.Addendum{Layer: l})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := crane.Push(img, fmt.Sprintf("%s:v0.1.0", repo)); err != nil {
+			t.Fatal(err)
+		}
+		d, err := img.Digest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	options := defaultCacheOptions()
+	options.CacheDir = t.TempDir()
+	cache := newLocalFileCache(options, logging.DefaultLogger(egv1a1.LogLevelInfo))
+
+	// The unsigned image is cached by a policy which doesn't require a signature.
+	unsignedDigest := pushImage("unsigned")
+	gotFilePath, _, err := cache.Get(downloadURL, GetOptions{
+		ResourceName:    "namespace.unsigned",
+		ResourceVersion: "0",
+		PullSecret:      []byte{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := generateModulePath(t, options.CacheDir, moduleNameFromURL(downloadURL), unsignedDigest.Hex+".wasm"); gotFilePath != want {
+		t.Fatalf("Wasm module local file path got %v, want %v", gotFilePath, want)
+	}
+
+	// The tag now points to a signed image, the cached unsigned module must not be used.
+	signedDigest := pushImage("signed")
+	pushSignature(t, repo, key, signedDigest, signedDigest)
+	gotFilePath, _, err = cache.Get(downloadURL, GetOptions{
+		ResourceName:    "namespace.signed",
+		ResourceVersion: "0",
+		PullSecret:      []byte{},
+		PublicKey:       publicKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := generateModulePath(t, options.CacheDir, moduleNameFromURL(downloadURL), signedDigest.Hex+".wasm"); gotFilePath != want {
+		t.Errorf("Wasm module local file path got %v, want %v", gotFilePath, want)
+	}
+
+	// The tag points back to the unsigned image, the verification fails.
+	pushImage("unsigned")
+	_, _, err = cache.Get(downloadURL, GetOptions{
+		ResourceName:    "namespace.signed",
+		ResourceVersion: "0",
+		PullSecret:      []byte{},
+		PublicKey:       publicKey,
+	})
+	if err == nil || !strings.Contains(err.Error(), "could not verify Wasm OCI image") {
+		t.Errorf("Get got error %v, want error containing %q", err, "could not verify Wasm OCI image")
+	}
+}
@@ -89,6 +89,8 @@ type bootstrapParameters struct {
 
 	// OtelMetricSinks defines the configuration of the OpenTelemetry sinks.
 	OtelMetricSinks []metricSink
+	// StatsDMetricSinks defines the configuration of the StatsD sinks.
+	StatsDMetricSinks []metricSink
 	// EnableStatConfig defines whether to customize the Envoy proxy stats.
 	EnableStatConfig bool
 	// StatsMatcher is to control creation of custom Envoy stats with prefix,
@@ -113,6 +115,8 @@ type metricSink struct {
 	Address string
 	// Port is the port of the XDS Server that Envoy is managed by.
 	Port uint32
+	// Prefix is added to the names of the stats sent to the sink.
+	Prefix string
 }
 
 type adminServerParameters struct {
@@ -170,6 +174,7 @@ func GetRenderedBootstrapConfig(opts *RenderBootstrapConfigOptions) (string, err
 		enablePrometheusCompression  = false
 		PrometheusCompressionLibrary = "gzip"
 		metricSinks                  []metricSink
+		statsDMetricSinks            []metricSink
 		StatsMatcher                 StatsMatcherParameters
 	)
 
@@ -212,6 +217,31 @@ func GetRenderedBootstrapConfig(opts *RenderBootstrapConfigOptions) (string, err
 			})
 		}
 
+		statsDAddresses := sets.NewString()
+		for _, sink := range proxyMetrics.Sinks {
+			if sink.StatsD == nil || len(sink.StatsD.BackendRefs) == 0 {
+				continue
+			}
+
+			// skip duplicate sinks
+			host, port := netutils.BackendHostAndPort(sink.StatsD.BackendRefs[0].BackendObjectReference, "")
+			addr := net.JoinHostPort(host, strconv.Itoa(int(port)))
+			if statsDAddresses.Has(addr) {
+				continue
+			}
+			statsDAddresses.Insert(addr)
+
+			var prefix string
+			if sink.StatsD.Prefix != nil {
+				prefix = *sink.StatsD.Prefix
+			}
+			statsDMetricSinks = append(statsDMetricSinks, metricSink{
+				Address: host,
+				Port:    port,
+				Prefix:  prefix,
+			})
+		}
+
 		if proxyMetrics.Matches != nil {
 			// Add custom envoy proxy stats
 			for _, match := range proxyMetrics.Matches {
@@ -262,6 +292,7 @@ func GetRenderedBootstrapConfig(opts *RenderBootstrapConfigOptions) (string, err
 			EnablePrometheusCompression:  enablePrometheusCompression,
 			PrometheusCompressionLibrary: PrometheusCompressionLibrary,
 			OtelMetricSinks:              metricSinks,
+			StatsDMetricSinks:            statsDMetricSinks,
 		},
 	}
 
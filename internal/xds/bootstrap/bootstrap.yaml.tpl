@@ -49,7 +49,7 @@ dynamic_resources:
   cds_config:
     ads: {}
     resource_api_version: V3
-{{- if .OtelMetricSinks }}
+{{- if or .OtelMetricSinks .StatsDMetricSinks }}
 stats_sinks:
 {{- range $idx, $sink := .OtelMetricSinks }}
 - name: "envoy.stat_sinks.open_telemetry"
@@ -59,6 +59,15 @@ stats_sinks:
       envoy_grpc:
         cluster_name: otel_metric_sink_{{ $idx }}
 {{- end }}
+{{- range $idx, $sink := .StatsDMetricSinks }}
+- name: "envoy.stat_sinks.statsd"
+  typed_config:
+    "@type": type.googleapis.com/envoy.config.metrics.v3.StatsdSink
+    tcp_cluster_name: statsd_metric_sink_{{ $idx }}
+    {{- if $sink.Prefix }}
+    prefix: {{ printf "%q" $sink.Prefix }}
+    {{- end }}
+{{- end }}
 {{- end }}
 static_resources:
   {{- if .EnablePrometheus }}
@@ -157,6 +166,21 @@ static_resources:
                 address: {{ $sink.Address }}
                 port_value: {{ $sink.Port }}
   {{- end }}
+  {{- range $idx, $sink := .StatsDMetricSinks }}
+  - name: statsd_metric_sink_{{ $idx }}
+    connect_timeout: 0.250s
+    type: STRICT_DNS
+    lb_policy: ROUND_ROBIN
+    load_assignment:
+      cluster_name: statsd_metric_sink_{{ $idx }}
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: {{ $sink.Address }}
+                port_value: {{ $sink.Port }}
+  {{- end }}
   - connect_timeout: 10s
     load_assignment:
       cluster_name: xds_cluster
@@ -113,6 +113,36 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 				SdsConfig: sds,
 			},
 		},
+		{
+			name: "statsd-metrics",
+			opts: &RenderBootstrapConfigOptions{
+				ProxyMetrics: &egv1a1.ProxyMetrics{
+					Prometheus: &egv1a1.ProxyPrometheusProvider{
+						Disable: true,
+					},
+					Sinks: []egv1a1.ProxyMetricSink{
+						{
+							Type: egv1a1.MetricSinkTypeStatsD,
+							StatsD: &egv1a1.ProxyStatsDSink{
+								BackendCluster: egv1a1.BackendCluster{
+									BackendRefs: []egv1a1.BackendRef{
+										{
+											BackendObjectReference: gwapiv1.BackendObjectReference{
+												Name:      "statsd-exporter",
+												Namespace: ptr.To(gwapiv1.Namespace("monitoring")),
+												Port:      ptr.To(gwapiv1.PortNumber(9125)),
+											},
+										},
+									},
+								},
+								Prefix: ptr.To("edge"),
+							},
+						},
+					},
+				},
+				SdsConfig: sds,
+			},
+		},
 		{
 			name: "custom-stats-matcher",
 			opts: &RenderBootstrapConfigOptions{
@@ -0,0 +1,175 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	simplehttpcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&cache{})
+}
+
+type cache struct{}
+
+var _ httpFilter = &cache{}
+
+// patchHCM builds and appends the cache Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a cache filter for each route that contains a response cache config.
+// The filter is disabled by default. It is enabled on the route level.
+func (*cache) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsResponseCache(route) {
+			continue
+		}
+
+		// Only generates one cache Envoy filter for each unique name.
+		// For example, if there are two routes under the same gateway with the
+		// same response cache config, only one cache filter will be generated.
+		if hcmContainsFilter(mgr, cacheFilterName(route.Traffic.ResponseCache)) {
+			continue
+		}
+
+		filter, err := buildHCMCacheFilter(route.Traffic.ResponseCache)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMCacheFilter returns a cache filter from the provided IR response cache.
+func buildHCMCacheFilter(responseCache *ir.ResponseCache) (*hcmv3.HttpFilter, error) {
+	cacheProto, err := cacheConfig(responseCache)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheAny, err := protocov.ToAnyWithValidation(cacheProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     cacheFilterName(responseCache),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: cacheAny,
+		},
+	}, nil
+}
+
+func cacheFilterName(responseCache *ir.ResponseCache) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCache, responseCache.Name)
+}
+
+func cacheConfig(responseCache *ir.ResponseCache) (*cachev3.CacheConfig, error) {
+	storeAny, err := protocov.ToAnyWithValidation(&simplehttpcachev3.SimpleHttpCacheConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	cacheProto := &cachev3.CacheConfig{
+		TypedConfig: storeAny,
+	}
+
+	for _, header := range responseCache.AllowedVaryHeaders {
+		cacheProto.AllowedVaryHeaders = append(cacheProto.AllowedVaryHeaders, &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Exact{
+				Exact: header,
+			},
+			IgnoreCase: true,
+		})
+	}
+
+	if len(responseCache.QueryParams) > 0 {
+		keyCreatorParams := &cachev3.CacheConfig_KeyCreatorParams{}
+		for _, param := range responseCache.QueryParams {
+			keyCreatorParams.QueryParametersIncluded = append(keyCreatorParams.QueryParametersIncluded,
+				&routev3.QueryParameterMatcher{
+					Name: param,
+					QueryParameterMatchSpecifier: &routev3.QueryParameterMatcher_PresentMatch{
+						PresentMatch: true,
+					},
+				})
+		}
+		cacheProto.KeyCreatorParams = keyCreatorParams
+	}
+
+	return cacheProto, nil
+}
+
+func routeContainsResponseCache(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil &&
+		irRoute.Traffic != nil &&
+		irRoute.Traffic.ResponseCache != nil
+}
+
+func (*cache) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the response cache config if applicable.
+// Note: this method enables the corresponding cache filter for the provided route,
+// and adds a Cache-Control header to the responses without one if a TTL is set.
+func (*cache) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsResponseCache(irRoute) {
+		return nil
+	}
+
+	responseCache := irRoute.Traffic.ResponseCache
+	if err := enableFilterOnRoute(route, cacheFilterName(responseCache)); err != nil {
+		return err
+	}
+
+	// The response headers of the route are added by the router filter, before the
+	// cache filter processes the response, so the cache uses the added max-age.
+	// The Cache-Control header set by the backend is kept, as it may forbid caching.
+	if responseCache.TTL != nil {
+		route.ResponseHeadersToAdd = append(route.ResponseHeadersToAdd, &corev3.HeaderValueOption{
+			Header: &corev3.HeaderValue{
+				Key:   "Cache-Control",
+				Value: fmt.Sprintf("max-age=%d", int64(responseCache.TTL.Seconds())),
+			},
+			AppendAction: corev3.HeaderValueOption_ADD_IF_ABSENT,
+		})
+	}
+
+	return nil
+}
@@ -139,16 +139,24 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 	}
 
 	for i, ds := range args.settings {
-		if ds.TLS != nil {
-			socket, err := buildXdsUpstreamTLSSocketWthCert(ds.TLS)
-			if err != nil {
-				// TODO: Log something here
-				return nil
+		if ds.TLS != nil || ds.ProxyProtocol != nil {
+			socket := args.tSocket
+			if ds.TLS != nil {
+				var err error
+				if socket, err = buildXdsUpstreamTLSSocketWthCert(ds.TLS); err != nil {
+					// TODO: Log something here
+					return nil
+				}
+			}
+			// The proxy protocol of the destination takes precedence over the one of the route.
+			proxyProtocol := args.proxyProtocol
+			if ds.ProxyProtocol != nil {
+				proxyProtocol = ds.ProxyProtocol
 			}
-			if args.proxyProtocol != nil {
-				socket = buildProxyProtocolSocket(args.proxyProtocol, socket)
+			if proxyProtocol != nil {
+				socket = buildProxyProtocolSocket(proxyProtocol, socket)
 			}
-			matchName := fmt.Sprintf("%s/tls/%d", args.name, i)
+			matchName := transportSocketMatchName(args.name, i, ds)
 			cluster.TransportSocketMatches = append(cluster.TransportSocketMatches, &clusterv3.Cluster_TransportSocketMatch{
 				Name: matchName,
 				Match: &structpb.Struct{
@@ -203,15 +211,16 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 		cluster.LbPolicy = clusterv3.Cluster_LEAST_REQUEST
 	} else if args.loadBalancer.LeastRequest != nil {
 		cluster.LbPolicy = clusterv3.Cluster_LEAST_REQUEST
-		if args.loadBalancer.LeastRequest.SlowStart != nil {
-			if args.loadBalancer.LeastRequest.SlowStart.Window != nil {
-				cluster.LbConfig = &clusterv3.Cluster_LeastRequestLbConfig_{
-					LeastRequestLbConfig: &clusterv3.Cluster_LeastRequestLbConfig{
-						SlowStartConfig: &clusterv3.Cluster_SlowStartConfig{
-							SlowStartWindow: durationpb.New(args.loadBalancer.LeastRequest.SlowStart.Window.Duration),
-						},
-					},
-				}
+		lrConfig := &clusterv3.Cluster_LeastRequestLbConfig{}
+		if args.loadBalancer.LeastRequest.SlowStart != nil && args.loadBalancer.LeastRequest.SlowStart.Window != nil {
+			lrConfig.SlowStartConfig = buildSlowStartConfig(args.name, args.loadBalancer.LeastRequest.SlowStart)
+		}
+		if args.loadBalancer.LeastRequest.ChoiceCount != nil {
+			lrConfig.ChoiceCount = wrapperspb.UInt32(*args.loadBalancer.LeastRequest.ChoiceCount)
+		}
+		if lrConfig.SlowStartConfig != nil || lrConfig.ChoiceCount != nil {
+			cluster.LbConfig = &clusterv3.Cluster_LeastRequestLbConfig_{
+				LeastRequestLbConfig: lrConfig,
 			}
 		}
 	} else if args.loadBalancer.RoundRobin != nil {
@@ -219,14 +228,14 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 		if args.loadBalancer.RoundRobin.SlowStart != nil && args.loadBalancer.RoundRobin.SlowStart.Window != nil {
 			cluster.LbConfig = &clusterv3.Cluster_RoundRobinLbConfig_{
 				RoundRobinLbConfig: &clusterv3.Cluster_RoundRobinLbConfig{
-					SlowStartConfig: &clusterv3.Cluster_SlowStartConfig{
-						SlowStartWindow: durationpb.New(args.loadBalancer.RoundRobin.SlowStart.Window.Duration),
-					},
+					SlowStartConfig: buildSlowStartConfig(args.name, args.loadBalancer.RoundRobin.SlowStart),
 				},
 			}
 		}
 	} else if args.loadBalancer.Random != nil {
 		cluster.LbPolicy = clusterv3.Cluster_RANDOM
+	} else if args.loadBalancer.ConsistentHash != nil && args.loadBalancer.ConsistentHash.RingHash {
+		cluster.LbPolicy = clusterv3.Cluster_RING_HASH
 	} else if args.loadBalancer.ConsistentHash != nil {
 		cluster.LbPolicy = clusterv3.Cluster_MAGLEV
 
@@ -255,6 +264,19 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 	return cluster
 }
 
+func buildSlowStartConfig(clusterName string, slowStart *ir.SlowStart) *clusterv3.Cluster_SlowStartConfig {
+	cfg := &clusterv3.Cluster_SlowStartConfig{
+		SlowStartWindow: durationpb.New(slowStart.Window.Duration),
+	}
+	if slowStart.Aggression != nil {
+		cfg.Aggression = &corev3.RuntimeDouble{
+			DefaultValue: float64(*slowStart.Aggression),
+			RuntimeKey:   fmt.Sprintf("upstream.%s.slow_start_aggression", clusterName),
+		}
+	}
+	return cfg
+}
+
 func buildXdsHealthCheck(healthcheck *ir.ActiveHealthCheck) []*corev3.HealthCheck {
 	hc := &corev3.HealthCheck{
 		Timeout:  durationpb.New(healthcheck.Timeout.Duration),
@@ -430,12 +452,12 @@ func buildXdsClusterLoadAssignment(clusterName string, destSettings []*ir.Destin
 		endpoints := make([]*endpointv3.LbEndpoint, 0, len(ds.Endpoints))
 
 		var metadata *corev3.Metadata
-		if ds.TLS != nil {
+		if ds.TLS != nil || ds.ProxyProtocol != nil {
 			metadata = &corev3.Metadata{
 				FilterMetadata: map[string]*structpb.Struct{
 					"envoy.transport_socket_match": {
 						Fields: map[string]*structpb.Value{
-							"name": structpb.NewStringValue(fmt.Sprintf("%s/tls/%d", clusterName, i)),
+							"name": structpb.NewStringValue(transportSocketMatchName(clusterName, i, ds)),
 						},
 					},
 				},
@@ -594,6 +616,15 @@ func buildTypedExtensionProtocolOptions(args *xdsClusterArgs) map[string]*anypb.
 	return extensionOptions
 }
 
+// transportSocketMatchName returns the name of the transport socket match of a destination setting,
+// which is set in the metadata of its endpoints.
+func transportSocketMatchName(clusterName string, index int, ds *ir.DestinationSetting) string {
+	if ds.TLS != nil {
+		return fmt.Sprintf("%s/tls/%d", clusterName, index)
+	}
+	return fmt.Sprintf("%s/proxyprotocol/%d", clusterName, index)
+}
+
 // buildProxyProtocolSocket builds the ProxyProtocol transport socket.
 func buildProxyProtocolSocket(proxyProtocol *ir.ProxyProtocol, tSocket *corev3.TransportSocket) *corev3.TransportSocket {
 	if proxyProtocol == nil {
@@ -809,6 +840,24 @@ func buildHTTP2Settings(opts *ir.HTTP2Settings) *corev3.Http2ProtocolOptions {
 		}
 	}
 
+	if opts.MaxOutboundFrames != nil {
+		out.MaxOutboundFrames = &wrapperspb.UInt32Value{
+			Value: *opts.MaxOutboundFrames,
+		}
+	}
+
+	if opts.MaxOutboundControlFrames != nil {
+		out.MaxOutboundControlFrames = &wrapperspb.UInt32Value{
+			Value: *opts.MaxOutboundControlFrames,
+		}
+	}
+
+	if opts.MaxConsecutiveInboundFramesWithEmptyPayload != nil {
+		out.MaxConsecutiveInboundFramesWithEmptyPayload = &wrapperspb.UInt32Value{
+			Value: *opts.MaxConsecutiveInboundFramesWithEmptyPayload,
+		}
+	}
+
 	if opts.ResetStreamOnError != nil {
 		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{
 			Value: *opts.ResetStreamOnError,
@@ -8,16 +8,19 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	brotliv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
 	gzipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	zstdv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
 	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -33,8 +36,11 @@ type compressor struct{}
 
 var _ httpFilter = &compressor{}
 
-// patchHCM builds and appends the compressor Filter to the HTTP Connection Manager
-// if applicable, and it does not already exist.
+// patchHCM builds and appends the compressor Filters to the HTTP Connection Manager
+// if applicable, and they do not already exist.
+// Note: the compressions with the default settings share one compressor filter for
+// each compression type, while the compressions with custom settings get a dedicated
+// compressor filter. All of them are disabled by default and enabled on the route level.
 func (*compressor) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
 	if mgr == nil {
 		return errors.New("hcm is nil")
@@ -43,58 +49,54 @@ func (*compressor) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTT
 		return errors.New("ir listener is nil")
 	}
 
-	var (
-		brotli bool
-		gzip   bool
-		filter *hcmv3.HttpFilter
-		err    error
-	)
-
+	compressions := make(map[string]*ir.Compression)
 	for _, route := range irListener.Routes {
 		if route.Traffic != nil && route.Traffic.Compression != nil {
 			for _, irComp := range route.Traffic.Compression {
-				if irComp.Type == egv1a1.BrotliCompressorType {
-					brotli = true
-				}
-				if irComp.Type == egv1a1.GzipCompressorType {
-					gzip = true
+				name := compressionFilterName(irComp)
+				if _, ok := compressions[name]; !ok {
+					compressions[name] = irComp
 				}
 			}
 		}
 	}
 
-	// Add the compressor filters for all the compression types required by the routes.
-	// All the compressor filters are disabled at the HCM level.
-	// The per route filter config will enable the compressor filters for the routes that require them.
-	if brotli {
-		brotliFilterName := compressorFilterName(egv1a1.BrotliCompressorType)
-		if !hcmContainsFilter(mgr, brotliFilterName) {
-			if filter, err = buildCompressorFilter(egv1a1.BrotliCompressorType); err != nil {
-				return err
-			}
-			mgr.HttpFilters = append(mgr.HttpFilters, filter)
-		}
+	// Add the compressor filters sorted by name, so the generated config is stable.
+	names := make([]string, 0, len(compressions))
+	for name := range compressions {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	if gzip {
-		gzipFilterName := compressorFilterName(egv1a1.GzipCompressorType)
-		if !hcmContainsFilter(mgr, gzipFilterName) {
-			if filter, err = buildCompressorFilter(egv1a1.GzipCompressorType); err != nil {
-				return err
-			}
-			mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	for _, name := range names {
+		if hcmContainsFilter(mgr, name) {
+			continue
 		}
+		filter, err := buildCompressorFilter(compressions[name])
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
 	}
 
-	return err
+	return nil
 }
 
 func compressorFilterName(compressorType egv1a1.CompressorType) string {
 	return fmt.Sprintf("%s.%s", egv1a1.EnvoyFilterCompressor.String(), strings.ToLower(string(compressorType)))
 }
 
-// buildCompressorFilter builds a compressor filter with the provided compressionType.
-func buildCompressorFilter(compressionType egv1a1.CompressorType) (*hcmv3.HttpFilter, error) {
+// compressionFilterName returns the name of the compressor filter used by the provided compression.
+func compressionFilterName(compression *ir.Compression) string {
+	name := compressorFilterName(compression.Type)
+	if compression.MinContentLength == nil && len(compression.ContentTypes) == 0 {
+		return name
+	}
+	return perRouteFilterName(egv1a1.EnvoyFilter(name), compression.Name)
+}
+
+// buildCompressorFilter builds a compressor filter with the provided compression.
+func buildCompressorFilter(compression *ir.Compression) (*hcmv3.HttpFilter, error) {
 	var (
 		compressorProto *compressorv3.Compressor
 		extensionName   string
@@ -104,13 +106,18 @@ func buildCompressorFilter(compressionType egv1a1.CompressorType) (*hcmv3.HttpFi
 		err             error
 	)
 
-	switch compressionType {
+	switch compression.Type {
 	case egv1a1.BrotliCompressorType:
 		extensionName = "envoy.compression.brotli.compressor"
 		extensionMsg = &brotliv3.Brotli{}
 	case egv1a1.GzipCompressorType:
 		extensionName = "envoy.compression.gzip.compressor"
 		extensionMsg = &gzipv3.Gzip{}
+	case egv1a1.ZstdCompressorType:
+		extensionName = "envoy.compression.zstd.compressor"
+		extensionMsg = &zstdv3.Zstd{}
+	default:
+		return nil, fmt.Errorf("unsupported compressor type: %s", compression.Type)
 	}
 
 	if extensionAny, err = protocov.ToAnyWithValidation(extensionMsg); err != nil {
@@ -124,12 +131,24 @@ func buildCompressorFilter(compressionType egv1a1.CompressorType) (*hcmv3.HttpFi
 		},
 	}
 
+	if compression.MinContentLength != nil || len(compression.ContentTypes) > 0 {
+		commonConfig := &compressorv3.Compressor_CommonDirectionConfig{
+			ContentType: compression.ContentTypes,
+		}
+		if compression.MinContentLength != nil {
+			commonConfig.MinContentLength = wrapperspb.UInt32(*compression.MinContentLength)
+		}
+		compressorProto.ResponseDirectionConfig = &compressorv3.Compressor_ResponseDirectionConfig{
+			CommonConfig: commonConfig,
+		}
+	}
+
 	if compressorAny, err = protocov.ToAnyWithValidation(compressorProto); err != nil {
 		return nil, err
 	}
 
 	return &hcmv3.HttpFilter{
-		Name: compressorFilterName(compressionType),
+		Name: compressionFilterName(compression),
 		ConfigType: &hcmv3.HttpFilter_TypedConfig{
 			TypedConfig: compressorAny,
 		},
@@ -154,57 +173,30 @@ func (*compressor) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error
 		return nil
 	}
 
-	var (
-		brotli        bool
-		gzip          bool
-		perFilterCfg  map[string]*anypb.Any
-		compressorAny *anypb.Any
-		err           error
-	)
-
-	for _, irComp := range irRoute.Traffic.Compression {
-		if irComp.Type == egv1a1.BrotliCompressorType {
-			brotli = true
-		}
-		if irComp.Type == egv1a1.GzipCompressorType {
-			gzip = true
-		}
-	}
-
-	if !brotli && !gzip {
-		return nil
-	}
-
 	// Overwrite the HCM level filter config with the per route filter config.
-	perFilterCfg = route.GetTypedPerFilterConfig()
-	if perFilterCfg == nil {
+	if route.GetTypedPerFilterConfig() == nil {
 		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
 	}
 
-	compressorProto := compressorPerRouteConfig()
-	if compressorAny, err = protocov.ToAnyWithValidation(compressorProto); err != nil {
+	compressorAny, err := protocov.ToAnyWithValidation(compressorPerRouteConfig())
+	if err != nil {
 		return err
 	}
 
-	if brotli {
-		brotliFilterName := compressorFilterName(egv1a1.BrotliCompressorType)
-		if _, ok := perFilterCfg[brotliFilterName]; ok {
-			// This should not happen since this is the only place where the filter
-			// config is added in a route.
-			return fmt.Errorf("route already contains filter config: %s, %+v",
-				brotliFilterName, route)
+	enabled := make(map[string]bool)
+	for _, irComp := range irRoute.Traffic.Compression {
+		filterName := compressionFilterName(irComp)
+		if enabled[filterName] {
+			continue
 		}
-		route.TypedPerFilterConfig[brotliFilterName] = compressorAny
-	}
-	if gzip {
-		gzipFilterName := compressorFilterName(egv1a1.GzipCompressorType)
-		if _, ok := perFilterCfg[gzipFilterName]; ok {
+		enabled[filterName] = true
+		if _, ok := route.TypedPerFilterConfig[filterName]; ok {
 			// This should not happen since this is the only place where the filter
 			// config is added in a route.
 			return fmt.Errorf("route already contains filter config: %s, %+v",
-				gzipFilterName, route)
+				filterName, route)
 		}
-		route.TypedPerFilterConfig[gzipFilterName] = compressorAny
+		route.TypedPerFilterConfig[filterName] = compressorAny
 	}
 
 	return nil
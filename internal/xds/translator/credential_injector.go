@@ -0,0 +1,157 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	credentialinjectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	genericv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&credentialInjector{})
+}
+
+type credentialInjector struct{}
+
+var _ httpFilter = &credentialInjector{}
+
+// patchHCM builds and appends the credential injector Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a credential injector filter for each credential injection config.
+// The filter is disabled by default. It is enabled on the route level.
+func (*credentialInjector) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if route.CredentialInjection == nil {
+			continue
+		}
+
+		// Only generates one credential injector Envoy filter for each unique name.
+		// For example, if two routes reference the same HTTPRouteFilter,
+		// only one credential injector filter will be generated.
+		if hcmContainsFilter(mgr, credentialInjectorFilterName(route.CredentialInjection)) {
+			continue
+		}
+
+		filter, err := buildHCMCredentialInjectorFilter(route.CredentialInjection)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMCredentialInjectorFilter returns a credential injector filter from the provided IR credential injection.
+// The credential is fetched from the SDS secret of the credential injection.
+func buildHCMCredentialInjectorFilter(credentialInjection *ir.CredentialInjection) (*hcmv3.HttpFilter, error) {
+	genericAny, err := protocov.ToAnyWithValidation(&genericv3.Generic{
+		Credential: &tlsv3.SdsSecretConfig{
+			Name:      credentialInjectorSecretName(credentialInjection),
+			SdsConfig: makeConfigSource(),
+		},
+		Header: credentialInjection.Header,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialInjectorAny, err := protocov.ToAnyWithValidation(&credentialinjectorv3.CredentialInjector{
+		// The header of the credential sent by the client, if any, is overwritten.
+		Overwrite: true,
+		Credential: &corev3.TypedExtensionConfig{
+			Name:        "envoy.http.injected_credentials.generic",
+			TypedConfig: genericAny,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     credentialInjectorFilterName(credentialInjection),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: credentialInjectorAny,
+		},
+	}, nil
+}
+
+func credentialInjectorFilterName(credentialInjection *ir.CredentialInjection) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCredentialInjector, credentialInjection.Name)
+}
+
+func credentialInjectorSecretName(credentialInjection *ir.CredentialInjection) string {
+	return fmt.Sprintf("credential_injector/%s", credentialInjection.Name)
+}
+
+// patchResources creates the SDS secrets holding the credentials of the routes, if needed.
+func (*credentialInjector) patchResources(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute) error {
+	var errs error
+
+	for _, route := range routes {
+		if route.CredentialInjection == nil {
+			continue
+		}
+
+		secret := &tlsv3.Secret{
+			Name: credentialInjectorSecretName(route.CredentialInjection),
+			Type: &tlsv3.Secret_GenericSecret{
+				GenericSecret: &tlsv3.GenericSecret{
+					Secret: &corev3.DataSource{
+						Specifier: &corev3.DataSource_InlineBytes{
+							InlineBytes: route.CredentialInjection.Credential,
+						},
+					},
+				},
+			},
+		}
+		if err := addXdsSecret(tCtx, secret); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// patchRoute patches the provided route with the credential injection config if applicable.
+// Note: this method enables the corresponding credential injector filter for the provided route.
+func (*credentialInjector) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.CredentialInjection == nil {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, credentialInjectorFilterName(irRoute.CredentialInjection))
+}
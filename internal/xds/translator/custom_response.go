@@ -22,6 +22,7 @@ import (
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -119,52 +120,47 @@ func (c *customResponse) customResponseConfig(ro *ir.ResponseOverride) (*respv3.
 			return nil, err
 		}
 
-		switch {
-		case len(r.Match.StatusCodes) == 0:
-			// This is just a sanity check, as the CRD validation should have caught this.
-			return nil, fmt.Errorf("missing status code in response override rule")
-		case len(r.Match.StatusCodes) == 1:
-			if predicate, err = c.buildSinglePredicate(r.Match.StatusCodes[0]); err != nil {
+		var predicates []*matcherv3.Matcher_MatcherList_Predicate
+		for _, codeMatch := range r.Match.StatusCodes {
+			if predicate, err = c.buildSinglePredicate(codeMatch); err != nil {
 				return nil, err
 			}
 
-			matcher := &matcherv3.Matcher_MatcherList_FieldMatcher{
-				Predicate: predicate,
-				OnMatch: &matcherv3.Matcher_OnMatch{
-					OnMatch: action,
-				},
-			}
-
-			matchers = append(matchers, matcher)
-		case len(r.Match.StatusCodes) > 1:
-			var predicates []*matcherv3.Matcher_MatcherList_Predicate
-
-			for _, codeMatch := range r.Match.StatusCodes {
-				if predicate, err = c.buildSinglePredicate(codeMatch); err != nil {
-					return nil, err
-				}
+			predicates = append(predicates, predicate)
+		}
 
-				predicates = append(predicates, predicate)
+		for _, failure := range r.Match.Failures {
+			if predicate, err = c.buildFailurePredicate(failure); err != nil {
+				return nil, err
 			}
 
-			// Create a single matcher that ORs all the predicates together.
-			// The rule will match if any of the codes match.
-			matcher := &matcherv3.Matcher_MatcherList_FieldMatcher{
-				Predicate: &matcherv3.Matcher_MatcherList_Predicate{
-					MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
-						OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
-							Predicate: predicates,
-						},
+			predicates = append(predicates, predicate)
+		}
+
+		switch {
+		case len(predicates) == 0:
+			// This is just a sanity check, as the CRD validation should have caught this.
+			return nil, fmt.Errorf("missing status code or failure in response override rule")
+		case len(predicates) == 1:
+			predicate = predicates[0]
+		default:
+			// Create a single predicate that ORs all the predicates together.
+			// The rule will match if any of the codes or failures match.
+			predicate = &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+					OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+						Predicate: predicates,
 					},
 				},
-				OnMatch: &matcherv3.Matcher_OnMatch{
-					OnMatch: action,
-				},
 			}
-
-			matchers = append(matchers, matcher)
 		}
 
+		matchers = append(matchers, &matcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: predicate,
+			OnMatch: &matcherv3.Matcher_OnMatch{
+				OnMatch: action,
+			},
+		})
 	}
 
 	// Create a MatcherList.
@@ -233,6 +229,48 @@ func (c *customResponse) buildSinglePredicate(codeMatch ir.StatusCodeMatch) (*ma
 	}
 }
 
+// responseFailureFlags maps the failures to the Envoy response flags, as exposed by the `response.flags` attribute.
+// https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes#response-attributes
+var responseFailureFlags = map[egv1a1.ResponseFailureType]int64{
+	egv1a1.ResponseFailureTypeNoHealthyUpstream:         0x2,  // UH
+	egv1a1.ResponseFailureTypeUpstreamTimeout:           0x4,  // UT
+	egv1a1.ResponseFailureTypeUpstreamConnectionFailure: 0x20, // UF
+	egv1a1.ResponseFailureTypeUpstreamOverflow:          0x80, // UO
+}
+
+func (c *customResponse) buildFailurePredicate(failure egv1a1.ResponseFailureType) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var (
+		httpAttributeCELInput *cncfv3.TypedExtensionConfig
+		responseFlagMatcher   *cncfv3.TypedExtensionConfig
+		err                   error
+	)
+
+	flag, ok := responseFailureFlags[failure]
+	if !ok {
+		// This is just a sanity check, as the CRD validation should have caught this.
+		return nil, fmt.Errorf("unsupported failure %s in response override rule", failure)
+	}
+
+	if httpAttributeCELInput, err = c.buildHTTPAttributeCELInput(); err != nil {
+		return nil, err
+	}
+
+	if responseFlagMatcher, err = c.buildResponseFlagCELMatcher(flag); err != nil {
+		return nil, err
+	}
+
+	return &matcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: httpAttributeCELInput,
+				Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: responseFlagMatcher,
+				},
+			},
+		},
+	}, nil
+}
+
 func (c *customResponse) buildHTTPAttributeCELInput() (*cncfv3.TypedExtensionConfig, error) {
 	var (
 		pb  *anypb.Any
@@ -376,14 +414,131 @@ func (c *customResponse) buildStatusCodeCELMatcher(codeRange ir.StatusCodeRange)
 	}, nil
 }
 
+func (c *customResponse) buildResponseFlagCELMatcher(flag int64) (*cncfv3.TypedExtensionConfig, error) {
+	var (
+		pb  *anypb.Any
+		err error
+	)
+
+	// CEL has no bitwise operators, so the flag is tested with arithmetic operators.
+	// Build the CEL expression AST: response.flags / flag % 2 == 1
+	matcher := &matcherv3.CelMatcher{
+		ExprMatch: &typev3.CelExpression{
+			ExprSpecifier: &typev3.CelExpression_ParsedExpr{
+				ParsedExpr: &expr.ParsedExpr{
+					Expr: &expr.Expr{
+						Id: 8,
+						ExprKind: &expr.Expr_CallExpr{
+							CallExpr: &expr.Expr_Call{
+								Function: "_==_",
+								Args: []*expr.Expr{
+									{
+										Id: 6,
+										ExprKind: &expr.Expr_CallExpr{
+											CallExpr: &expr.Expr_Call{
+												Function: "_%_",
+												Args: []*expr.Expr{
+													{
+														Id: 4,
+														ExprKind: &expr.Expr_CallExpr{
+															CallExpr: &expr.Expr_Call{
+																Function: "_/_",
+																Args: []*expr.Expr{
+																	{
+																		Id: 2,
+																		ExprKind: &expr.Expr_SelectExpr{
+																			SelectExpr: &expr.Expr_Select{
+																				Operand: &expr.Expr{
+																					Id: 1,
+																					ExprKind: &expr.Expr_IdentExpr{
+																						IdentExpr: &expr.Expr_Ident{
+																							Name: "response",
+																						},
+																					},
+																				},
+																				Field: "flags",
+																			},
+																		},
+																	},
+																	{
+																		Id: 3,
+																		ExprKind: &expr.Expr_ConstExpr{
+																			ConstExpr: &expr.Constant{
+																				ConstantKind: &expr.Constant_Int64Value{
+																					Int64Value: flag,
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+													{
+														Id: 5,
+														ExprKind: &expr.Expr_ConstExpr{
+															ConstExpr: &expr.Constant{
+																ConstantKind: &expr.Constant_Int64Value{
+																	Int64Value: 2,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									{
+										Id: 7,
+										ExprKind: &expr.Expr_ConstExpr{
+											ConstExpr: &expr.Constant{
+												ConstantKind: &expr.Constant_Int64Value{
+													Int64Value: 1,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := matcher.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	if pb, err = protocov.ToAnyWithValidation(matcher); err != nil {
+		return nil, err
+	}
+
+	return &cncfv3.TypedExtensionConfig{
+		Name:        "cel-matcher",
+		TypedConfig: pb,
+	}, nil
+}
+
 func (c *customResponse) buildAction(r ir.ResponseOverrideRule) (*matcherv3.Matcher_OnMatch_Action, error) {
 	response := &policyv3.LocalResponsePolicy{}
 	if r.Response.Body != nil && *r.Response.Body != "" {
-		response.Body = &corev3.DataSource{
+		body := &corev3.DataSource{
 			Specifier: &corev3.DataSource_InlineString{
 				InlineString: *r.Response.Body,
 			},
 		}
+		if r.Response.Templated {
+			// The command operators are only substituted in the body format.
+			response.BodyFormat = &corev3.SubstitutionFormatString{
+				Format: &corev3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: body,
+				},
+				ContentType: ptr.Deref(r.Response.ContentType, ""),
+			}
+		} else {
+			response.Body = body
+		}
 	}
 
 	if r.Response.ContentType != nil && *r.Response.ContentType != "" {
@@ -0,0 +1,117 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	grpcjsontranscoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&grpcJSONTranscoder{})
+}
+
+type grpcJSONTranscoder struct{}
+
+var _ httpFilter = &grpcJSONTranscoder{}
+
+// patchHCM builds and appends the gRPC-JSON transcoder Filters to the HTTP Connection Manager
+// if applicable, and they do not already exist.
+// Note: this method creates a gRPC-JSON transcoder filter for each policy with a gRPC-JSON
+// transcoder config. The filter is disabled by default. It is enabled on the route level.
+func (*grpcJSONTranscoder) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	var errs error
+	for _, route := range irListener.Routes {
+		if !routeContainsGRPCJSONTranscoder(route) {
+			continue
+		}
+
+		transcoder := route.EnvoyExtensions.GRPCJSONTranscoder
+		if hcmContainsFilter(mgr, grpcJSONTranscoderFilterName(transcoder)) {
+			continue
+		}
+
+		filter, err := buildHCMGRPCJSONTranscoderFilter(transcoder)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMGRPCJSONTranscoderFilter returns a gRPC-JSON transcoder filter from the provided IR.
+func buildHCMGRPCJSONTranscoderFilter(transcoder *ir.GRPCJSONTranscoder) (*hcmv3.HttpFilter, error) {
+	transcoderProto := &grpcjsontranscoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &grpcjsontranscoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: transcoder.ProtoDescriptor,
+		},
+		Services: transcoder.Services,
+		// The route is selected with the original path of the request, rather than
+		// with the path of the gRPC method the request is transcoded to.
+		MatchIncomingRequestRoute: true,
+	}
+
+	transcoderAny, err := protocov.ToAnyWithValidation(transcoderProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     grpcJSONTranscoderFilterName(transcoder),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: transcoderAny,
+		},
+	}, nil
+}
+
+func grpcJSONTranscoderFilterName(transcoder *ir.GRPCJSONTranscoder) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterGRPCJSONTranscoder, transcoder.Name)
+}
+
+// routeContainsGRPCJSONTranscoder returns true if a gRPC-JSON transcoder exists for the provided route.
+func routeContainsGRPCJSONTranscoder(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil &&
+		irRoute.EnvoyExtensions != nil &&
+		irRoute.EnvoyExtensions.GRPCJSONTranscoder != nil
+}
+
+func (*grpcJSONTranscoder) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route so the gRPC-JSON transcoder filter is enabled if applicable.
+func (*grpcJSONTranscoder) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsGRPCJSONTranscoder(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, grpcJSONTranscoderFilterName(irRoute.EnvoyExtensions.GRPCJSONTranscoder))
+}
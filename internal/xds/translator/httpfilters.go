@@ -80,9 +80,11 @@ type OrderedHTTPFilters []*OrderedHTTPFilter
 // balancer determines whether envoy should receive traffic based on the health check result which
 // only depending on the current draining state of the envoy, result should not be affected by other
 // filters, or else user traffic disruption may happen.
-// the fault filter should be placed in the second position because
+// the json_to_metadata filter should be placed in the second position because it may change the
+// route matched by the request, which must be selected before the other filters apply the route config.
+// the fault filter should be placed in the third position because
 // it doesn't rely on the functionality of other filters, and rejecting early can save computation costs
-// for the remaining filters, the cors filter should be put at the third to avoid unnecessary
+// for the remaining filters, the cors filter should be put at the fourth to avoid unnecessary
 // processing of other filters for unauthorized cross-region access.
 // The router filter must be the last one since it's a terminal filter.
 //
@@ -100,24 +102,28 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 	switch {
 	case isFilterType(filter, egv1a1.EnvoyFilterHealthCheck):
 		order = 0
-	case isFilterType(filter, egv1a1.EnvoyFilterFault):
+	case isFilterType(filter, egv1a1.EnvoyFilterIPTagging):
 		order = 1
-	case isFilterType(filter, egv1a1.EnvoyFilterCORS):
+	case isFilterType(filter, egv1a1.EnvoyFilterJSONToMetadata):
 		order = 2
-	case isFilterType(filter, egv1a1.EnvoyFilterExtAuthz):
+	case isFilterType(filter, egv1a1.EnvoyFilterFault):
 		order = 3
-	case isFilterType(filter, egv1a1.EnvoyFilterAPIKeyAuth):
+	case isFilterType(filter, egv1a1.EnvoyFilterCORS):
 		order = 4
-	case isFilterType(filter, egv1a1.EnvoyFilterBasicAuth):
+	case isFilterType(filter, egv1a1.EnvoyFilterExtAuthz):
 		order = 5
-	case isFilterType(filter, egv1a1.EnvoyFilterOAuth2):
+	case isFilterType(filter, egv1a1.EnvoyFilterAPIKeyAuth):
 		order = 6
-	case isFilterType(filter, egv1a1.EnvoyFilterJWTAuthn):
+	case isFilterType(filter, egv1a1.EnvoyFilterBasicAuth):
 		order = 7
-	case isFilterType(filter, egv1a1.EnvoyFilterSessionPersistence):
+	case isFilterType(filter, egv1a1.EnvoyFilterOAuth2):
 		order = 8
+	case isFilterType(filter, egv1a1.EnvoyFilterJWTAuthn):
+		order = 9
+	case isFilterType(filter, egv1a1.EnvoyFilterSessionPersistence):
+		order = 10
 	case isFilterType(filter, egv1a1.EnvoyFilterLua):
-		order = 9 + mustGetFilterIndex(filter.Name)
+		order = 11 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterExtProc):
 		order = 100 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterWasm):
@@ -132,8 +138,14 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 		order = 304
 	case isFilterType(filter, egv1a1.EnvoyFilterCompressor):
 		order = 305
-	case isFilterType(filter, egv1a1.EnvoyFilterRouter):
+	case isFilterType(filter, egv1a1.EnvoyFilterCache):
 		order = 306
+	case isFilterType(filter, egv1a1.EnvoyFilterGRPCJSONTranscoder):
+		order = 307
+	case isFilterType(filter, egv1a1.EnvoyFilterCredentialInjector):
+		order = 308
+	case isFilterType(filter, egv1a1.EnvoyFilterRouter):
+		order = 309
 	}
 
 	return &OrderedHTTPFilter{
@@ -0,0 +1,103 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	iptaggingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ip_tagging/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&ipTagging{})
+}
+
+type ipTagging struct{}
+
+var _ httpFilter = &ipTagging{}
+
+// patchHCM builds and appends the ip_tagging Filter to the HTTP Connection Manager if applicable.
+func (*ipTagging) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if irListener.IPTagging == nil {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	if hcmContainsFilter(mgr, string(egv1a1.EnvoyFilterIPTagging)) {
+		return nil
+	}
+
+	filter, err := buildIPTaggingFilter(irListener.IPTagging)
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	return nil
+}
+
+// buildIPTaggingFilter builds an ip_tagging filter from the provided IR IP tagging settings.
+func buildIPTaggingFilter(ipTagging *ir.IPTagging) (*hcmv3.HttpFilter, error) {
+	ipTaggingProto := &iptaggingv3.IPTagging{
+		// The tags are applied to all the requests, whether they are internal or external.
+		RequestType: iptaggingv3.IPTagging_BOTH,
+		// The values of the header sent by the clients are removed, so the tags can't be spoofed.
+		IpTagHeader: &iptaggingv3.IPTagging_IpTagHeader{
+			Header: ipTagging.Header,
+			Action: iptaggingv3.IPTagging_IpTagHeader_SANITIZE,
+		},
+	}
+
+	for _, tag := range ipTagging.Tags {
+		ipTag := &iptaggingv3.IPTagging_IPTag{
+			IpTagName: tag.Name,
+		}
+		for _, cidr := range tag.CIDRs {
+			ipTag.IpList = append(ipTag.IpList, &corev3.CidrRange{
+				AddressPrefix: cidr.IP,
+				PrefixLen:     wrapperspb.UInt32(cidr.MaskLen),
+			})
+		}
+		ipTaggingProto.IpTags = append(ipTaggingProto.IpTags, ipTag)
+	}
+
+	ipTaggingAny, err := protocov.ToAnyWithValidation(ipTaggingProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name: string(egv1a1.EnvoyFilterIPTagging),
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: ipTaggingAny,
+		},
+	}, nil
+}
+
+func (*ipTagging) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+func (*ipTagging) patchRoute(*routev3.Route, *ir.HTTPRoute) error {
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	jsontometadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/json_to_metadata/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	// bodyMatchMetadataNamespace is the dynamic metadata namespace where the json_to_metadata
+	// filter stores the fields of the request body used to match the routes.
+	bodyMatchMetadataNamespace = "envoy-gateway.body-match"
+	// bodyMatchMissingMetadataNamespace is the dynamic metadata namespace where the json_to_metadata
+	// filter records the fields missing from the request body, or the body which failed to be parsed.
+	bodyMatchMissingMetadataNamespace = "envoy-gateway.body-match-missing"
+)
+
+func init() {
+	registerHTTPFilter(&jsonToMetadata{})
+}
+
+type jsonToMetadata struct{}
+
+var _ httpFilter = &jsonToMetadata{}
+
+// patchHCM builds and appends the json_to_metadata Filter to the HTTP Connection Manager
+// if one of the routes of the listener matches on the request body.
+// The filter copies the fields of the JSON request body matched by the routes to the dynamic
+// metadata and clears the route cache, so the route is selected again with the metadata.
+// Note: the filter is disabled by default. It is enabled on the body match probe routes.
+func (*jsonToMetadata) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	// Return early if filter already exists.
+	if hcmContainsFilter(mgr, string(egv1a1.EnvoyFilterJSONToMetadata)) {
+		return nil
+	}
+
+	var (
+		paths []string
+		seen  = make(map[string]bool)
+	)
+	for _, route := range irListener.Routes {
+		if route.BodyMatch != nil && !seen[route.BodyMatch.Name] {
+			seen[route.BodyMatch.Name] = true
+			paths = append(paths, route.BodyMatch.Name)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	filter, err := buildJSONToMetadataFilter(paths)
+	if err != nil {
+		return err
+	}
+	mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	return nil
+}
+
+// buildJSONToMetadataFilter builds a json_to_metadata filter storing the value of
+// each of the JSON paths in the dynamic metadata, using the path as key.
+func buildJSONToMetadataFilter(paths []string) (*hcmv3.HttpFilter, error) {
+	rules := make([]*jsontometadatav3.JsonToMetadata_Rule, 0, len(paths))
+	for _, path := range paths {
+		var selectors []*jsontometadatav3.JsonToMetadata_Selector
+		for _, key := range strings.Split(path, ".") {
+			selectors = append(selectors, &jsontometadatav3.JsonToMetadata_Selector{
+				Selector: &jsontometadatav3.JsonToMetadata_Selector_Key{
+					Key: key,
+				},
+			})
+		}
+		rules = append(rules, &jsontometadatav3.JsonToMetadata_Rule{
+			Selectors: selectors,
+			OnPresent: &jsontometadatav3.JsonToMetadata_KeyValuePair{
+				MetadataNamespace: bodyMatchMetadataNamespace,
+				Key:               path,
+				Type:              jsontometadatav3.JsonToMetadata_STRING,
+			},
+			OnMissing: bodyMatchMissingKeyValuePair(path),
+			OnError:   bodyMatchMissingKeyValuePair(path),
+		})
+	}
+
+	jsonToMetadataProto := &jsontometadatav3.JsonToMetadata{
+		RequestRules: &jsontometadatav3.JsonToMetadata_MatchRules{
+			Rules: rules,
+		},
+	}
+	jsonToMetadataAny, err := protocov.ToAnyWithValidation(jsonToMetadataProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     string(egv1a1.EnvoyFilterJSONToMetadata),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: jsonToMetadataAny,
+		},
+	}, nil
+}
+
+// bodyMatchMissingKeyValuePair returns the metadata recording that the field at the
+// path is missing from the request body, so that the body match probe routes are not
+// selected again once the route cache is cleared.
+func bodyMatchMissingKeyValuePair(path string) *jsontometadatav3.JsonToMetadata_KeyValuePair {
+	return &jsontometadatav3.JsonToMetadata_KeyValuePair{
+		MetadataNamespace: bodyMatchMissingMetadataNamespace,
+		Key:               path,
+		ValueType: &jsontometadatav3.JsonToMetadata_KeyValuePair_Value{
+			Value: structpb.NewBoolValue(true),
+		},
+	}
+}
+
+// buildBodyMatchProbeRoute returns the route selected before the json_to_metadata filter has
+// processed the request body, for the requests matching the other conditions of a route with a
+// body match. The filter is only enabled on this route, so that only the bodies of these requests
+// are buffered. The route has the same match as the body match route without the body match, and
+// it must be placed right after it. Once the filter stored the field of the body, or recorded that
+// it is missing, the probe route is no longer matched and the route is selected again.
+func buildBodyMatchProbeRoute(xdsRoute *routev3.Route, bodyMatch *ir.StringMatch) (*routev3.Route, error) {
+	match := proto.Clone(xdsRoute.Match).(*routev3.RouteMatch)
+	match.DynamicMetadata = nil
+	for _, m := range xdsRoute.Match.DynamicMetadata {
+		if m.Filter != bodyMatchMetadataNamespace {
+			match.DynamicMetadata = append(match.DynamicMetadata, m)
+		}
+	}
+	match.DynamicMetadata = append(match.DynamicMetadata,
+		buildBodyMetadataAbsentMatcher(bodyMatchMetadataNamespace, bodyMatch.Name),
+		buildBodyMetadataAbsentMatcher(bodyMatchMissingMetadataNamespace, bodyMatch.Name))
+
+	probeRoute := &routev3.Route{
+		Name:  xdsRoute.Name + "/body-match-probe",
+		Match: match,
+		// This response is only sent if the filter did not process the request body.
+		Action: &routev3.Route_DirectResponse{
+			DirectResponse: &routev3.DirectResponseAction{
+				Status: http.StatusNotFound,
+			},
+		},
+	}
+	if err := enableFilterOnRoute(probeRoute, string(egv1a1.EnvoyFilterJSONToMetadata)); err != nil {
+		return nil, err
+	}
+	return probeRoute, nil
+}
+
+// buildBodyMetadataAbsentMatcher builds the matcher of the absence of the dynamic metadata
+// at the key of the namespace.
+func buildBodyMetadataAbsentMatcher(namespace, key string) *matcherv3.MetadataMatcher {
+	return &matcherv3.MetadataMatcher{
+		Filter: namespace,
+		Path: []*matcherv3.MetadataMatcher_PathSegment{
+			{
+				Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+					Key: key,
+				},
+			},
+		},
+		Value: &matcherv3.ValueMatcher{
+			MatchPattern: &matcherv3.ValueMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		},
+		Invert: true,
+	}
+}
+
+// buildBodyMetadataMatcher builds the matcher of the dynamic metadata set by the
+// json_to_metadata filter for the body match of a route.
+func buildBodyMetadataMatcher(bodyMatch *ir.StringMatch) *matcherv3.MetadataMatcher {
+	return &matcherv3.MetadataMatcher{
+		Filter: bodyMatchMetadataNamespace,
+		Path: []*matcherv3.MetadataMatcher_PathSegment{
+			{
+				Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+					Key: bodyMatch.Name,
+				},
+			},
+		},
+		Value: &matcherv3.ValueMatcher{
+			MatchPattern: &matcherv3.ValueMatcher_StringMatch{
+				StringMatch: buildXdsStringMatcher(bodyMatch),
+			},
+		},
+	}
+}
+
+func (*jsonToMetadata) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+func (*jsonToMetadata) patchRoute(*routev3.Route, *ir.HTTPRoute) error {
+	return nil
+}
@@ -102,6 +102,24 @@ func http2ProtocolOptions(opts *ir.HTTP2Settings) *corev3.Http2ProtocolOptions {
 		},
 	}
 
+	if opts.MaxOutboundFrames != nil {
+		out.MaxOutboundFrames = &wrapperspb.UInt32Value{
+			Value: *opts.MaxOutboundFrames,
+		}
+	}
+
+	if opts.MaxOutboundControlFrames != nil {
+		out.MaxOutboundControlFrames = &wrapperspb.UInt32Value{
+			Value: *opts.MaxOutboundControlFrames,
+		}
+	}
+
+	if opts.MaxConsecutiveInboundFramesWithEmptyPayload != nil {
+		out.MaxConsecutiveInboundFramesWithEmptyPayload = &wrapperspb.UInt32Value{
+			Value: *opts.MaxConsecutiveInboundFramesWithEmptyPayload,
+		}
+	}
+
 	if opts.ResetStreamOnError != nil {
 		out.OverrideStreamErrorOnInvalidHttpMessage = &wrapperspb.BoolValue{
 			Value: *opts.ResetStreamOnError,
@@ -353,10 +371,14 @@ func (t *Translator) addHCMToXDSListener(xdsListener *listenerv3.Listener, irLis
 		if irListener.Timeout.HTTP.IdleTimeout != nil {
 			mgr.CommonHttpProtocolOptions.IdleTimeout = durationpb.New(irListener.Timeout.HTTP.IdleTimeout.Duration)
 		}
+
+		if irListener.Timeout.HTTP.StreamIdleTimeout != nil {
+			mgr.StreamIdleTimeout = durationpb.New(irListener.Timeout.HTTP.StreamIdleTimeout.Duration)
+		}
 	}
 
 	// Add the proxy protocol filter if needed
-	patchProxyProtocolFilter(xdsListener, irListener.EnableProxyProtocol)
+	patchProxyProtocolFilter(xdsListener, irListener.EnableProxyProtocol, irListener.OptionalProxyProtocol)
 
 	if irListener.IsHTTP2 {
 		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCWeb)
@@ -368,6 +390,14 @@ func (t *Translator) addHCMToXDSListener(xdsListener *listenerv3.Listener, irLis
 		mgr.CodecType = hcmv3.HttpConnectionManager_HTTP3
 		mgr.Http3ProtocolOptions = &corev3.Http3ProtocolOptions{}
 	}
+
+	// The CONNECT-UDP requests are sent as extended CONNECT requests over HTTP/2 and HTTP/3.
+	if listenerContainsConnectTermination(irListener) {
+		mgr.Http2ProtocolOptions.AllowConnect = true
+		if mgr.Http3ProtocolOptions != nil {
+			mgr.Http3ProtocolOptions.AllowExtendedConnect = true
+		}
+	}
 	// Add HTTP filters to the HCM, the filters have already been sorted in the
 	// correct order in the patchHCMWithFilters function.
 	if err := t.patchHCMWithFilters(mgr, irListener); err != nil {
@@ -427,6 +457,16 @@ func (t *Translator) addHCMToXDSListener(xdsListener *listenerv3.Listener, irLis
 	return nil
 }
 
+// listenerContainsConnectTermination returns true if a route of the listener terminates the CONNECT requests.
+func listenerContainsConnectTermination(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if route.ConnectTermination {
+			return true
+		}
+	}
+	return false
+}
+
 func buildEarlyHeaderMutation(headers *ir.HeaderSettings) []*corev3.TypedExtensionConfig {
 	if headers == nil || (len(headers.EarlyAddRequestHeaders) == 0 && len(headers.EarlyRemoveRequestHeaders) == 0) {
 		return nil
@@ -567,6 +607,14 @@ func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irRoute *ir.TCPRoute
 		HashPolicy: buildTCPProxyHashPolicy(irRoute.LoadBalancer),
 	}
 
+	if irRoute.Tunneling != nil {
+		// Tunnel the TCP connections to the upstream in HTTP CONNECT requests.
+		// Reference: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/upgrades#tunneling-tcp-over-http
+		mgr.TunnelingConfig = &tcpv3.TcpProxy_TunnelingConfig{
+			Hostname: irRoute.Tunneling.Hostname,
+		}
+	}
+
 	if timeout != nil && timeout.TCP != nil {
 		if timeout.TCP.IdleTimeout != nil {
 			mgr.IdleTimeout = durationpb.New(timeout.TCP.IdleTimeout.Duration)
@@ -751,10 +799,18 @@ func setDownstreamTLSSessionSettings(tlsConfig *ir.TLSConfig, tlsCtx *tlsv3.Down
 		tlsCtx.DisableStatefulSessionResumption = true
 	}
 
-	if !tlsConfig.StatelessSessionResumption {
+	switch {
+	case !tlsConfig.StatelessSessionResumption:
 		tlsCtx.SessionTicketKeysType = &tlsv3.DownstreamTlsContext_DisableStatelessSessionResumption{
 			DisableStatelessSessionResumption: true,
 		}
+	case tlsConfig.SessionTicketKeys != nil:
+		tlsCtx.SessionTicketKeysType = &tlsv3.DownstreamTlsContext_SessionTicketKeysSdsSecretConfig{
+			SessionTicketKeysSdsSecretConfig: &tlsv3.SdsSecretConfig{
+				Name:      tlsConfig.SessionTicketKeys.Name,
+				SdsConfig: makeConfigSource(),
+			},
+		}
 	}
 }
 
@@ -825,14 +881,40 @@ func buildXdsTLSCertSecret(tlsConfig ir.TLSCertificate) *tlsv3.Secret {
 	}
 }
 
-func buildXdsTLSCaCertSecret(caCertificate *ir.TLSCACertificate) *tlsv3.Secret {
+func buildXdsTLSCaCertSecret(caCertificate *ir.TLSCACertificate, crl *ir.TLSCrl) *tlsv3.Secret {
+	validationContext := &tlsv3.CertificateValidationContext{
+		TrustedCa: &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineBytes{InlineBytes: caCertificate.Certificate},
+		},
+	}
+	if crl != nil {
+		validationContext.Crl = &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineBytes{InlineBytes: crl.Data},
+		}
+		validationContext.OnlyVerifyLeafCertCrl = crl.OnlyVerifyLeafCertificate
+	}
+
 	return &tlsv3.Secret{
 		Name: caCertificate.Name,
 		Type: &tlsv3.Secret_ValidationContext{
-			ValidationContext: &tlsv3.CertificateValidationContext{
-				TrustedCa: &corev3.DataSource{
-					Specifier: &corev3.DataSource_InlineBytes{InlineBytes: caCertificate.Certificate},
-				},
+			ValidationContext: validationContext,
+		},
+	}
+}
+
+func buildXdsTLSSessionTicketKeysSecret(sessionTicketKeys *ir.TLSSessionTicketKeys) *tlsv3.Secret {
+	keys := make([]*corev3.DataSource, 0, len(sessionTicketKeys.Keys))
+	for _, key := range sessionTicketKeys.Keys {
+		keys = append(keys, &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineBytes{InlineBytes: key},
+		})
+	}
+
+	return &tlsv3.Secret{
+		Name: sessionTicketKeys.Name,
+		Type: &tlsv3.Secret_SessionTicketKeys{
+			SessionTicketKeys: &tlsv3.TlsSessionTicketKeys{
+				Keys: keys,
 			},
 		},
 	}
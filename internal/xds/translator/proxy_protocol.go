@@ -14,7 +14,7 @@ import (
 
 // patchProxyProtocolFilter builds and appends the Proxy Protocol Filter to the
 // HTTP Listener's Listener Filters if applicable.
-func patchProxyProtocolFilter(xdsListener *listenerv3.Listener, enableProxyProtocol bool) {
+func patchProxyProtocolFilter(xdsListener *listenerv3.Listener, enableProxyProtocol, optionalProxyProtocol bool) {
 	// Return early if unset
 	if xdsListener == nil || !enableProxyProtocol {
 		return
@@ -27,7 +27,7 @@ func patchProxyProtocolFilter(xdsListener *listenerv3.Listener, enableProxyProto
 		}
 	}
 
-	proxyProtocolFilter := buildProxyProtocolFilter()
+	proxyProtocolFilter := buildProxyProtocolFilter(optionalProxyProtocol)
 
 	if proxyProtocolFilter != nil {
 		// Add the Proxy Protocol filter as first to listeners.
@@ -36,8 +36,10 @@ func patchProxyProtocolFilter(xdsListener *listenerv3.Listener, enableProxyProto
 }
 
 // buildProxypProtocolFilter returns a Proxy Protocol listener filter from the provided IR listener.
-func buildProxyProtocolFilter() *listenerv3.ListenerFilter {
-	pp := &proxyprotocolv3.ProxyProtocol{}
+func buildProxyProtocolFilter(optionalProxyProtocol bool) *listenerv3.ListenerFilter {
+	pp := &proxyprotocolv3.ProxyProtocol{
+		AllowRequestsWithoutProxyProtocol: optionalProxyProtocol,
+	}
 
 	ppAny, err := anypb.New(pp)
 	if err != nil {
@@ -46,7 +46,7 @@ func TestPatchProxyProtocolFilter(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			patchProxyProtocolFilter(tc.listener, enableProxyProtocol)
+			patchProxyProtocolFilter(tc.listener, enableProxyProtocol, false)
 			// proxy proto filter should be added always as first
 			require.Equal(t, wellknown.ProxyProtocol, tc.listener.ListenerFilters[0].Name)
 		})
@@ -35,6 +35,17 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 		Metadata: buildXdsMetadata(httpRoute.Metadata),
 	}
 
+	if httpRoute.ConnectTermination {
+		// The CONNECT requests have no path, and are only matched by the connect matcher.
+		router.Match.PathSpecifier = &routev3.RouteMatch_ConnectMatcher_{
+			ConnectMatcher: &routev3.RouteMatch_ConnectMatcher{},
+		}
+	}
+
+	if httpRoute.BodyMatch != nil {
+		router.Match.DynamicMetadata = append(router.Match.DynamicMetadata, buildBodyMetadataMatcher(httpRoute.BodyMatch))
+	}
+
 	if len(httpRoute.AddRequestHeaders) > 0 {
 		router.RequestHeadersToAdd = buildXdsAddedHeaders(httpRoute.AddRequestHeaders)
 	}
@@ -60,15 +71,7 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 		}
 
-		if !httpRoute.IsHTTP2 {
-			// Allow websocket upgrades for HTTP 1.1
-			// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
-			routeAction.UpgradeConfigs = []*routev3.RouteAction_UpgradeConfig{
-				{
-					UpgradeType: "websocket",
-				},
-			}
-		}
+		routeAction.UpgradeConfigs = buildUpgradeConfigs(httpRoute)
 
 		router.Action = &routev3.Route_Route{Route: routeAction}
 	default:
@@ -79,15 +82,7 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 		if httpRoute.Mirrors != nil {
 			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 		}
-		if !httpRoute.IsHTTP2 {
-			// Allow websocket upgrades for HTTP 1.1
-			// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
-			routeAction.UpgradeConfigs = []*routev3.RouteAction_UpgradeConfig{
-				{
-					UpgradeType: "websocket",
-				},
-			}
-		}
+		routeAction.UpgradeConfigs = buildUpgradeConfigs(httpRoute)
 		router.Action = &routev3.Route_Route{Route: routeAction}
 	}
 
@@ -114,6 +109,11 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 		}
 	}
 
+	// Tracing
+	if httpRoute.Traffic != nil {
+		router.Tracing = buildRouteTracing(httpRoute.Traffic.Tracing)
+	}
+
 	// Add per route filter configs to the route, if needed.
 	if err := patchRouteWithPerRouteConfig(router, httpRoute); err != nil {
 		return nil, err
@@ -122,6 +122,55 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 	return router, nil
 }
 
+func buildUpgradeConfigs(httpRoute *ir.HTTPRoute) []*routev3.RouteAction_UpgradeConfig {
+	if httpRoute.ConnectTermination {
+		// Terminate the CONNECT requests, and tunnel their payload to the upstream over TCP.
+		// The CONNECT-UDP requests of RFC 9298 are also terminated, and their payload is
+		// tunneled to the same upstream address over UDP.
+		// Reference: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/upgrades#tunneling-tcp-over-http
+		// Reference: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/upgrades#tunneling-udp-over-http
+		return []*routev3.RouteAction_UpgradeConfig{
+			{
+				UpgradeType:   "CONNECT",
+				ConnectConfig: &routev3.RouteAction_UpgradeConfig_ConnectConfig{},
+			},
+			{
+				UpgradeType:   "CONNECT-UDP",
+				ConnectConfig: &routev3.RouteAction_UpgradeConfig_ConnectConfig{},
+			},
+		}
+	}
+
+	var upgradeConfigs []*routev3.RouteAction_UpgradeConfig
+	websocketConfigured := false
+	if httpRoute.Traffic != nil {
+		for _, upgrade := range httpRoute.Traffic.HTTPUpgrade {
+			upgradeConfig := &routev3.RouteAction_UpgradeConfig{
+				UpgradeType: upgrade.Type,
+			}
+			if upgrade.Disabled {
+				upgradeConfig.Enabled = wrapperspb.Bool(false)
+			}
+			upgradeConfigs = append(upgradeConfigs, upgradeConfig)
+			if upgrade.Type == "websocket" {
+				websocketConfigured = true
+			}
+		}
+	}
+
+	if !httpRoute.IsHTTP2 && !websocketConfigured {
+		// Allow websocket upgrades for HTTP 1.1
+		// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
+		upgradeConfigs = append([]*routev3.RouteAction_UpgradeConfig{
+			{
+				UpgradeType: "websocket",
+			},
+		}, upgradeConfigs...)
+	}
+
+	return upgradeConfigs
+}
+
 func buildXdsRouteMatch(pathMatch *ir.StringMatch, headerMatches []*ir.StringMatch, queryParamMatches []*ir.StringMatch) *routev3.RouteMatch {
 	outMatch := &routev3.RouteMatch{}
 
@@ -302,6 +351,14 @@ func getEffectiveRequestTimeout(httpRoute *ir.HTTPRoute) *metav1.Duration {
 }
 
 func idleTimeout(httpRoute *ir.HTTPRoute) *durationpb.Duration {
+	// The stream idle timeout of the route takes precedence
+	if httpRoute.Traffic != nil &&
+		httpRoute.Traffic.Timeout != nil &&
+		httpRoute.Traffic.Timeout.HTTP != nil &&
+		httpRoute.Traffic.Timeout.HTTP.StreamIdleTimeout != nil {
+		return durationpb.New(httpRoute.Traffic.Timeout.HTTP.StreamIdleTimeout.Duration)
+	}
+
 	rt := getEffectiveRequestTimeout(httpRoute)
 	timeout := time.Hour // Default to 1 hour
 	if rt != nil {
@@ -565,6 +622,9 @@ func buildHashPolicy(httpRoute *ir.HTTPRoute) []*routev3.RouteAction_HashPolicy
 		if ch.Cookie.TTL != nil {
 			hashPolicy.GetCookie().Ttl = durationpb.New(ch.Cookie.TTL.Duration)
 		}
+		if ch.Cookie.Path != nil {
+			hashPolicy.GetCookie().Path = *ch.Cookie.Path
+		}
 		if ch.Cookie.Attributes != nil {
 			attributes := make([]*routev3.RouteAction_HashPolicy_CookieAttribute, 0, len(ch.Cookie.Attributes))
 			for name, value := range ch.Cookie.Attributes {
@@ -576,6 +636,15 @@ func buildHashPolicy(httpRoute *ir.HTTPRoute) []*routev3.RouteAction_HashPolicy
 			hashPolicy.GetCookie().Attributes = attributes
 		}
 		return []*routev3.RouteAction_HashPolicy{hashPolicy}
+	case ch.QueryParameter != nil:
+		hashPolicy := &routev3.RouteAction_HashPolicy{
+			PolicySpecifier: &routev3.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &routev3.RouteAction_HashPolicy_QueryParameter{
+					Name: ch.QueryParameter.Name,
+				},
+			},
+		}
+		return []*routev3.RouteAction_HashPolicy{hashPolicy}
 	case ch.SourceIP != nil:
 		if !*ch.SourceIP {
 			return nil
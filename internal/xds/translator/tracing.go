@@ -10,6 +10,7 @@ import (
 	"sort"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	tracecfg "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tracingtype "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
@@ -165,6 +166,32 @@ func buildHCMTracing(tracing *ir.Tracing) (*hcm.HttpConnectionManager_Tracing, e
 	}, nil
 }
 
+// buildRouteTracing overrides the sampling of the listener tracing for a route.
+func buildRouteTracing(tracing *ir.RouteTracing) *routev3.Tracing {
+	if tracing == nil {
+		return nil
+	}
+
+	if tracing.Disable {
+		return &routev3.Tracing{
+			ClientSampling:  &xdstype.FractionalPercent{Numerator: 0},
+			RandomSampling:  &xdstype.FractionalPercent{Numerator: 0},
+			OverallSampling: &xdstype.FractionalPercent{Numerator: 0},
+		}
+	}
+
+	if tracing.SamplingRate != nil {
+		return &routev3.Tracing{
+			RandomSampling: &xdstype.FractionalPercent{
+				Numerator:   *tracing.SamplingRate,
+				Denominator: xdstype.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+
+	return nil
+}
+
 func processClusterForTracing(tCtx *types.ResourceVersionTable, tracing *ir.Tracing, metrics *ir.Metrics) error {
 	if tracing == nil {
 		return nil
@@ -420,12 +420,19 @@ func (t *Translator) processHTTPListenerXdsTranslation(
 			}
 
 			if httpListener.TLS.CACertificate != nil {
-				caSecret := buildXdsTLSCaCertSecret(httpListener.TLS.CACertificate)
+				caSecret := buildXdsTLSCaCertSecret(httpListener.TLS.CACertificate, httpListener.TLS.Crl)
 				if err = tCtx.AddXdsResource(resourcev3.SecretType, caSecret); err != nil {
 					errs = errors.Join(errs, err)
 				}
 			}
 
+			if httpListener.TLS.SessionTicketKeys != nil {
+				keysSecret := buildXdsTLSSessionTicketKeysSecret(httpListener.TLS.SessionTicketKeys)
+				if err = tCtx.AddXdsResource(resourcev3.SecretType, keysSecret); err != nil {
+					errs = errors.Join(errs, err)
+				}
+			}
+
 		}
 
 		// add http route client certs
@@ -541,6 +548,11 @@ func (t *Translator) addRouteToRouteConfig(
 			continue
 		}
 
+		if metrics != nil && metrics.EnableRouteStats {
+			// Remove dots from the route name since dots are special chars used in stats tag extraction in Envoy
+			xdsRoute.StatPrefix = strings.ReplaceAll(httpRoute.Name, ".", "_")
+		}
+
 		// Check if an extension want to modify the route we just generated
 		// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op.
 		if err = processExtensionPostRouteHook(xdsRoute, vHost, httpRoute, t.ExtensionManager); err != nil {
@@ -565,6 +577,16 @@ func (t *Translator) addRouteToRouteConfig(
 		}
 		vHost.Routes = append(vHost.Routes, xdsRoute)
 
+		// The body match probe route must follow the body match route, see buildBodyMatchProbeRoute.
+		if httpRoute.BodyMatch != nil {
+			var probeRoute *routev3.Route
+			if probeRoute, err = buildBodyMatchProbeRoute(xdsRoute, httpRoute.BodyMatch); err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				vHost.Routes = append(vHost.Routes, probeRoute)
+			}
+		}
+
 		if httpRoute.Destination != nil {
 			ea := &ExtraArgs{
 				metrics:       metrics,
@@ -726,7 +748,7 @@ func (t *Translator) processTCPListenerXdsTranslation(
 		// same EnableProxyProtocol value, otherwise listeners with EnableProxyProtocol=false will
 		// never accept connection, because listeners with EnableProxyProtocol=true has configured
 		// proxy protocol listener filter for xDS listener, all connection must have ProxyProtocol header.
-		patchProxyProtocolFilter(xdsListener, tcpListener.EnableProxyProtocol)
+		patchProxyProtocolFilter(xdsListener, tcpListener.EnableProxyProtocol, tcpListener.OptionalProxyProtocol)
 
 		for _, route := range tcpListener.Routes {
 			if err := processXdsCluster(tCtx, &TCPRouteTranslator{route}, &ExtraArgs{metrics: metrics}); err != nil {
@@ -748,11 +770,17 @@ func (t *Translator) processTCPListenerXdsTranslation(
 					}
 				}
 				if route.TLS.Terminate.CACertificate != nil {
-					caSecret := buildXdsTLSCaCertSecret(route.TLS.Terminate.CACertificate)
+					caSecret := buildXdsTLSCaCertSecret(route.TLS.Terminate.CACertificate, route.TLS.Terminate.Crl)
 					if err := tCtx.AddXdsResource(resourcev3.SecretType, caSecret); err != nil {
 						errs = errors.Join(errs, err)
 					}
 				}
+				if route.TLS.Terminate.SessionTicketKeys != nil {
+					keysSecret := buildXdsTLSSessionTicketKeysSecret(route.TLS.Terminate.SessionTicketKeys)
+					if err := tCtx.AddXdsResource(resourcev3.SecretType, keysSecret); err != nil {
+						errs = errors.Join(errs, err)
+					}
+				}
 			}
 			if err := addXdsTCPFilterChain(xdsListener, route, route.Destination.Name, accesslog, tcpListener.Timeout, tcpListener.Connection); err != nil {
 				errs = errors.Join(errs, err)
@@ -1019,6 +1047,48 @@ func buildXdsUpstreamTLSCASecret(tlsConfig *ir.TLSUpstreamConfig) *tlsv3.Secret
 	}
 }
 
+// buildUpstreamSubjectAltNameMatchers returns the matchers of the Subject Alternative Names
+// of the backend certificate, the SNI is matched when no Subject Alternative Name is set.
+func buildUpstreamSubjectAltNameMatchers(tlsConfig *ir.TLSUpstreamConfig) []*tlsv3.SubjectAltNameMatcher {
+	if len(tlsConfig.SubjectAltNames) == 0 {
+		return []*tlsv3.SubjectAltNameMatcher{
+			{
+				SanType: tlsv3.SubjectAltNameMatcher_DNS,
+				Matcher: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: tlsConfig.SNI,
+					},
+				},
+			},
+		}
+	}
+
+	matchers := make([]*tlsv3.SubjectAltNameMatcher, 0, len(tlsConfig.SubjectAltNames))
+	for _, san := range tlsConfig.SubjectAltNames {
+		switch {
+		case san.Hostname != nil:
+			matchers = append(matchers, &tlsv3.SubjectAltNameMatcher{
+				SanType: tlsv3.SubjectAltNameMatcher_DNS,
+				Matcher: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: *san.Hostname,
+					},
+				},
+			})
+		case san.URI != nil:
+			matchers = append(matchers, &tlsv3.SubjectAltNameMatcher{
+				SanType: tlsv3.SubjectAltNameMatcher_URI,
+				Matcher: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: *san.URI,
+					},
+				},
+			})
+		}
+	}
+	return matchers
+}
+
 func buildXdsUpstreamTLSSocketWthCert(tlsConfig *ir.TLSUpstreamConfig) (*corev3.TransportSocket, error) {
 	tlsCtx := &tlsv3.UpstreamTlsContext{
 		CommonTlsContext: &tlsv3.CommonTlsContext{
@@ -1030,16 +1100,7 @@ func buildXdsUpstreamTLSSocketWthCert(tlsConfig *ir.TLSUpstreamConfig) (*corev3.
 						SdsConfig: makeConfigSource(),
 					},
 					DefaultValidationContext: &tlsv3.CertificateValidationContext{
-						MatchTypedSubjectAltNames: []*tlsv3.SubjectAltNameMatcher{
-							{
-								SanType: tlsv3.SubjectAltNameMatcher_DNS,
-								Matcher: &matcherv3.StringMatcher{
-									MatchPattern: &matcherv3.StringMatcher_Exact{
-										Exact: tlsConfig.SNI,
-									},
-								},
-							},
-						},
+						MatchTypedSubjectAltNames: buildUpstreamSubjectAltNameMatchers(tlsConfig),
 					},
 				},
 			},
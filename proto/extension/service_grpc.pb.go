@@ -28,6 +28,7 @@ const (
 	EnvoyGatewayExtension_PostVirtualHostModify_FullMethodName  = "/envoygateway.extension.EnvoyGatewayExtension/PostVirtualHostModify"
 	EnvoyGatewayExtension_PostHTTPListenerModify_FullMethodName = "/envoygateway.extension.EnvoyGatewayExtension/PostHTTPListenerModify"
 	EnvoyGatewayExtension_PostTranslateModify_FullMethodName    = "/envoygateway.extension.EnvoyGatewayExtension/PostTranslateModify"
+	EnvoyGatewayExtension_PostInfraModify_FullMethodName        = "/envoygateway.extension.EnvoyGatewayExtension/PostInfraModify"
 )
 
 // EnvoyGatewayExtensionClient is the client API for EnvoyGatewayExtension service.
@@ -59,6 +60,13 @@ type EnvoyGatewayExtensionClient interface {
 	// The list of clusters and secrets returned by the extension are used as the final list of all clusters and secrets
 	// PostTranslateModify is always executed when an extension is loaded
 	PostTranslateModify(ctx context.Context, in *PostTranslateModifyRequest, opts ...grpc.CallOption) (*PostTranslateModifyResponse, error)
+	// PostInfraModify allows an extension to modify a Kubernetes resource (e.g. Deployment, Service, HorizontalPodAutoscaler)
+	// generated by Envoy Gateway for an Envoy Proxy fleet before it is applied.
+	// This allows for injecting organization specific settings such as sidecar containers or labels without having to
+	// fork the infrastructure manager.
+	// PostInfraModify is only executed when an extension is loaded with the Infrastructure Resources post hook. An extension
+	// may return nil in order to not make any changes to the resource.
+	PostInfraModify(ctx context.Context, in *PostInfraModifyRequest, opts ...grpc.CallOption) (*PostInfraModifyResponse, error)
 }
 
 type envoyGatewayExtensionClient struct {
@@ -105,6 +113,15 @@ func (c *envoyGatewayExtensionClient) PostTranslateModify(ctx context.Context, i
 	return out, nil
 }
 
+func (c *envoyGatewayExtensionClient) PostInfraModify(ctx context.Context, in *PostInfraModifyRequest, opts ...grpc.CallOption) (*PostInfraModifyResponse, error) {
+	out := new(PostInfraModifyResponse)
+	err := c.cc.Invoke(ctx, EnvoyGatewayExtension_PostInfraModify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvoyGatewayExtensionServer is the server API for EnvoyGatewayExtension service.
 // All implementations must embed UnimplementedEnvoyGatewayExtensionServer
 // for forward compatibility
@@ -134,6 +151,13 @@ type EnvoyGatewayExtensionServer interface {
 	// The list of clusters and secrets returned by the extension are used as the final list of all clusters and secrets
 	// PostTranslateModify is always executed when an extension is loaded
 	PostTranslateModify(context.Context, *PostTranslateModifyRequest) (*PostTranslateModifyResponse, error)
+	// PostInfraModify allows an extension to modify a Kubernetes resource (e.g. Deployment, Service, HorizontalPodAutoscaler)
+	// generated by Envoy Gateway for an Envoy Proxy fleet before it is applied.
+	// This allows for injecting organization specific settings such as sidecar containers or labels without having to
+	// fork the infrastructure manager.
+	// PostInfraModify is only executed when an extension is loaded with the Infrastructure Resources post hook. An extension
+	// may return nil in order to not make any changes to the resource.
+	PostInfraModify(context.Context, *PostInfraModifyRequest) (*PostInfraModifyResponse, error)
 	mustEmbedUnimplementedEnvoyGatewayExtensionServer()
 }
 
@@ -153,6 +177,9 @@ func (UnimplementedEnvoyGatewayExtensionServer) PostHTTPListenerModify(context.C
 func (UnimplementedEnvoyGatewayExtensionServer) PostTranslateModify(context.Context, *PostTranslateModifyRequest) (*PostTranslateModifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostTranslateModify not implemented")
 }
+func (UnimplementedEnvoyGatewayExtensionServer) PostInfraModify(context.Context, *PostInfraModifyRequest) (*PostInfraModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostInfraModify not implemented")
+}
 func (UnimplementedEnvoyGatewayExtensionServer) mustEmbedUnimplementedEnvoyGatewayExtensionServer() {}
 
 // UnsafeEnvoyGatewayExtensionServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +265,24 @@ func _EnvoyGatewayExtension_PostTranslateModify_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnvoyGatewayExtension_PostInfraModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostInfraModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyGatewayExtensionServer).PostInfraModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyGatewayExtension_PostInfraModify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyGatewayExtensionServer).PostInfraModify(ctx, req.(*PostInfraModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnvoyGatewayExtension_ServiceDesc is the grpc.ServiceDesc for EnvoyGatewayExtension service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +306,10 @@ var EnvoyGatewayExtension_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PostTranslateModify",
 			Handler:    _EnvoyGatewayExtension_PostTranslateModify_Handler,
 		},
+		{
+			MethodName: "PostInfraModify",
+			Handler:    _EnvoyGatewayExtension_PostInfraModify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/extension/service.proto",
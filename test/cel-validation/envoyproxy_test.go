@@ -898,6 +898,59 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{"host or backendRefs needs to be set"},
 		},
+		{
+			desc: "ProxyPrometheusProvider-with-gzip-compression",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Prometheus: &egv1a1.ProxyPrometheusProvider{
+								Compression: &egv1a1.Compression{
+									Type: egv1a1.GzipCompressorType,
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "ProxyPrometheusProvider-with-zstd-compression",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Prometheus: &egv1a1.ProxyPrometheusProvider{
+								Compression: &egv1a1.Compression{
+									Type: egv1a1.ZstdCompressorType,
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"Zstd compressor is not supported on the Prometheus endpoint"},
+		},
+		{
+			desc: "ProxyPrometheusProvider-with-compression-settings",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Prometheus: &egv1a1.ProxyPrometheusProvider{
+								Compression: &egv1a1.Compression{
+									Type:             egv1a1.GzipCompressorType,
+									MinContentLength: ptr.To[uint32](100),
+									ContentTypes:     []string{"text/plain"},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"minContentLength and contentTypes are not supported on the Prometheus endpoint"},
+		},
 		{
 			desc: "ProxyMetricSink-with-TypeOpenTelemetry-but-no-openTelemetry",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
@@ -954,6 +1007,72 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{"host or backendRefs needs to be set"},
 		},
+		{
+			desc: "ProxyMetricSink-with-TypeStatsD-but-no-statsD",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Sinks: []egv1a1.ProxyMetricSink{
+								{
+									Type: egv1a1.MetricSinkTypeStatsD,
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"If MetricSink type is StatsD, statsD field needs to be set"},
+		},
+		{
+			desc: "ProxyMetrics-statsd-sinks-backend-empty",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Sinks: []egv1a1.ProxyMetricSink{
+								{
+									Type:   egv1a1.MetricSinkTypeStatsD,
+									StatsD: &egv1a1.ProxyStatsDSink{},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"backendRefs needs to be set"},
+		},
+		{
+			desc: "ProxyMetrics-statsd-sinks-backendref",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Telemetry: &egv1a1.ProxyTelemetry{
+						Metrics: &egv1a1.ProxyMetrics{
+							Sinks: []egv1a1.ProxyMetricSink{
+								{
+									Type: egv1a1.MetricSinkTypeStatsD,
+									StatsD: &egv1a1.ProxyStatsDSink{
+										BackendCluster: egv1a1.BackendCluster{
+											BackendRefs: []egv1a1.BackendRef{
+												{
+													BackendObjectReference: gwapiv1.BackendObjectReference{
+														Name: "statsd-exporter",
+														Kind: ptr.To(gwapiv1.Kind("Service")),
+														Port: ptr.To(gwapiv1.PortNumber(9125)),
+													},
+												},
+											},
+										},
+										Prefix: ptr.To("edge"),
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
 		{
 			desc: "ProxyMetrics-sinks-backendref",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
@@ -1347,6 +1466,83 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{"cannot use envoyHpa if envoyDaemonSet is used"},
 		},
+		{
+			desc: "EnvoyDeployment-with-host-network-and-one-replica",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egv1a1.KubernetesDeploymentSpec{
+								Replicas: ptr.To[int32](1),
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "EnvoyDeployment-with-host-network-and-replicas",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egv1a1.KubernetesDeploymentSpec{
+								Replicas: ptr.To[int32](2),
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"hostNetwork cannot be used with more than one replica of envoyDeployment, use envoyDaemonSet to run one Envoy pod per node"},
+		},
+		{
+			desc: "EnvoyDeployment-with-host-network-and-EnvoyHpa",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egv1a1.KubernetesDeploymentSpec{
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+							EnvoyHpa: &egv1a1.KubernetesHorizontalPodAutoscalerSpec{
+								MaxReplicas: ptr.To[int32](10),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"hostNetwork cannot be used with more than one replica of envoyDeployment, use envoyDaemonSet to run one Envoy pod per node"},
+		},
+		{
+			desc: "EnvoyDaemonSet-with-host-network",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egv1a1.KubernetesDaemonSetSpec{
+								Pod: &egv1a1.KubernetesPodSpec{
+									HostNetwork: ptr.To(true),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
 		{
 			desc: "EnvoyDeployment-and-EnvoyDaemonSet-both-used",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
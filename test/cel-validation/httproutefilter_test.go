@@ -139,6 +139,35 @@ func TestHTTPRouteFilter(t *testing.T) {
 			},
 			wantErrors: []string{"spec.urlRewrite.hostname: Invalid value: \"object\": header must be nil if the type is not Header"},
 		},
+		{
+			desc: "valid bodyMatch",
+			mutate: func(httproutefilter *egv1a1.HTTPRouteFilter) {
+				httproutefilter.Spec = egv1a1.HTTPRouteFilterSpec{
+					BodyMatch: &egv1a1.HTTPBodyMatch{
+						JSONPath: "header.action",
+						Value: egv1a1.StringMatch{
+							Type:  ptr.To(egv1a1.StringMatchPrefix),
+							Value: "urn:orders:",
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "invalid bodyMatch jsonPath",
+			mutate: func(httproutefilter *egv1a1.HTTPRouteFilter) {
+				httproutefilter.Spec = egv1a1.HTTPRouteFilterSpec{
+					BodyMatch: &egv1a1.HTTPBodyMatch{
+						JSONPath: "header..action",
+						Value: egv1a1.StringMatch{
+							Value: "urn:orders:get",
+						},
+					},
+				}
+			},
+			wantErrors: []string{"spec.bodyMatch.jsonPath: Invalid value: \"header..action\": spec.bodyMatch.jsonPath in body should match '^[^.]+(\\.[^.]+)*$'"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -190,6 +190,26 @@ func TestSecurityPolicyTarget(t *testing.T) {
 				"spec: Invalid value: \"object\": this policy does not yet support the sectionName field",
 			},
 		},
+		{
+			desc: "sectionName with HTTPRoute",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+									Kind:  gwapiv1a2.Kind("HTTPRoute"),
+									Name:  gwapiv1a2.ObjectName("httpbin-route"),
+								},
+								SectionName: &sectionName,
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
 
 		// cors
 		{
@@ -1179,6 +1199,45 @@ func TestSecurityPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{"Retry timeout is not supported", "HTTPStatusCodes is not supported"},
 		},
+		{
+			desc: "oidc-stream-idle-timeout",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					OIDC: &egv1a1.OIDC{
+						Provider: egv1a1.OIDCProvider{
+							BackendCluster: egv1a1.BackendCluster{
+								BackendSettings: &egv1a1.ClusterSettings{
+									Timeout: &egv1a1.Timeout{
+										HTTP: &egv1a1.HTTPTimeout{
+											StreamIdleTimeout: ptr.To(gwapiv1.Duration("1m")),
+										},
+									},
+								},
+							},
+							Issuer:                "https://accounts.google.com",
+							AuthorizationEndpoint: ptr.To("https://accounts.google.com/o/oauth2/v2/auth"),
+							TokenEndpoint:         ptr.To("https://oauth2.googleapis.com/token"),
+						},
+						ClientID: "client-id",
+						ClientSecret: gwapiv1b1.SecretObjectReference{
+							Name: "secret",
+						},
+					},
+				}
+			},
+			wantErrors: []string{"streamIdleTimeout is only supported by BackendTrafficPolicy"},
+		},
 	}
 
 	for _, tc := range cases {